@@ -0,0 +1,175 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsMediaLiveInput() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsMediaLiveInputRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"input_class": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"attached_channels": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"destinations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip":          {Type: schema.TypeString, Computed: true},
+						"port":        {Type: schema.TypeString, Computed: true},
+						"url":         {Type: schema.TypeString, Computed: true},
+						"stream_name": {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+
+			"sources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {Type: schema.TypeString, Computed: true},
+						"url":      {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsMediaLiveInputRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	inputId, idOk := d.GetOk("id")
+	name, nameOk := d.GetOk("name")
+
+	if !idOk && !nameOk {
+		return fmt.Errorf("one of `id` or `name` must be set")
+	}
+
+	var input *medialive.Input
+
+	if idOk {
+		resp, err := conn.DescribeInput(&medialive.DescribeInputInput{
+			InputId: aws.String(inputId.(string)),
+		})
+		if err != nil {
+			return fmt.Errorf("error describing MediaLive Input: %s", err)
+		}
+		input = describeInputOutputToInput(resp)
+	} else {
+		found, err := findMediaLiveInputByName(conn, name.(string))
+		if err != nil {
+			return err
+		}
+		input = found
+	}
+
+	if input == nil {
+		return fmt.Errorf("no MediaLive Input found matching criteria")
+	}
+
+	d.SetId(aws.StringValue(input.Id))
+	d.Set("name", aws.StringValue(input.Name))
+	d.Set("arn", aws.StringValue(input.Arn))
+	d.Set("input_class", aws.StringValue(input.InputClass))
+	d.Set("type", aws.StringValue(input.Type))
+	d.Set("attached_channels", aws.StringValueSlice(input.AttachedChannels))
+
+	if err := d.Set("destinations", flattenInputDestinations(input.Destinations)); err != nil {
+		return fmt.Errorf("error setting destinations: %s", err)
+	}
+
+	if err := d.Set("sources", flattenInputSources(input.Sources)); err != nil {
+		return fmt.Errorf("error setting sources: %s", err)
+	}
+
+	if err := d.Set("tags", keyvaluetags.MedialiveKeyValueTags(input.Tags).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+// findMediaLiveInputByName paginates ListInputs looking for a name match,
+// as DescribeInput only accepts an Input ID.
+func findMediaLiveInputByName(conn *medialive.MediaLive, name string) (*medialive.Input, error) {
+	var found *medialive.Input
+
+	err := conn.ListInputsPages(&medialive.ListInputsInput{}, func(page *medialive.ListInputsOutput, lastPage bool) bool {
+		for _, input := range page.Inputs {
+			if aws.StringValue(input.Name) == name {
+				found = input
+				return false
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing MediaLive Inputs: %s", err)
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("no MediaLive Input found with name: %s", name)
+	}
+
+	return found, nil
+}
+
+func describeInputOutputToInput(resp *medialive.DescribeInputOutput) *medialive.Input {
+	return &medialive.Input{
+		Arn:               resp.Arn,
+		AttachedChannels:  resp.AttachedChannels,
+		Destinations:      resp.Destinations,
+		Id:                resp.Id,
+		InputClass:        resp.InputClass,
+		InputDevices:      resp.InputDevices,
+		MediaConnectFlows: resp.MediaConnectFlows,
+		Name:              resp.Name,
+		RoleArn:           resp.RoleArn,
+		SecurityGroups:    resp.SecurityGroups,
+		Sources:           resp.Sources,
+		State:             resp.State,
+		Tags:              resp.Tags,
+		Type:              resp.Type,
+	}
+}
@@ -0,0 +1,155 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceAwsMediaLiveInputDevice() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsMediaLiveInputDeviceRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"serial_number": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"mac_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"connection_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"hd_device_settings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"configured_input": {Type: schema.TypeString, Computed: true},
+						"latency_ms":        {Type: schema.TypeInt, Computed: true},
+						"max_bitrate":       {Type: schema.TypeInt, Computed: true},
+					},
+				},
+			},
+
+			"uhd_device_settings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"configured_input": {Type: schema.TypeString, Computed: true},
+						"latency_ms":        {Type: schema.TypeInt, Computed: true},
+						"max_bitrate":       {Type: schema.TypeInt, Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsMediaLiveInputDeviceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	deviceId, idOk := d.GetOk("id")
+	serialNumber, serialOk := d.GetOk("serial_number")
+
+	if !idOk && !serialOk {
+		return fmt.Errorf("one of `id` or `serial_number` must be set")
+	}
+
+	var device *medialive.DescribeInputDeviceOutput
+
+	if idOk {
+		resp, err := conn.DescribeInputDevice(&medialive.DescribeInputDeviceInput{
+			InputDeviceId: aws.String(deviceId.(string)),
+		})
+		if err != nil {
+			return fmt.Errorf("error describing MediaLive Input Device: %s", err)
+		}
+		device = resp
+	} else {
+		found, err := findMediaLiveInputDeviceBySerialNumber(conn, serialNumber.(string))
+		if err != nil {
+			return err
+		}
+		device = found
+	}
+
+	if device == nil {
+		return fmt.Errorf("no MediaLive Input Device found matching criteria")
+	}
+
+	d.SetId(aws.StringValue(device.Id))
+	d.Set("serial_number", aws.StringValue(device.SerialNumber))
+	d.Set("name", aws.StringValue(device.Name))
+	d.Set("arn", aws.StringValue(device.Arn))
+	d.Set("type", aws.StringValue(device.Type))
+	d.Set("mac_address", aws.StringValue(device.MacAddress))
+	d.Set("connection_state", aws.StringValue(device.ConnectionState))
+
+	if err := d.Set("hd_device_settings", flattenInputDeviceHdSettings(device.HdDeviceSettings)); err != nil {
+		return fmt.Errorf("error setting hd_device_settings: %s", err)
+	}
+
+	if err := d.Set("uhd_device_settings", flattenInputDeviceUhdSettings(device.UhdDeviceSettings)); err != nil {
+		return fmt.Errorf("error setting uhd_device_settings: %s", err)
+	}
+
+	return nil
+}
+
+// findMediaLiveInputDeviceBySerialNumber paginates ListInputDevices looking
+// for a serial number match, as DescribeInputDevice only accepts a device ID
+// -- and the serial number printed on the physical unit is what an operator
+// pairing a new Link/HD/UHD encoder actually has in hand.
+func findMediaLiveInputDeviceBySerialNumber(conn *medialive.MediaLive, serialNumber string) (*medialive.DescribeInputDeviceOutput, error) {
+	var found *medialive.InputDeviceSummary
+
+	err := conn.ListInputDevicesPages(&medialive.ListInputDevicesInput{}, func(page *medialive.ListInputDevicesOutput, lastPage bool) bool {
+		for _, device := range page.InputDevices {
+			if aws.StringValue(device.SerialNumber) == serialNumber {
+				found = device
+				return false
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing MediaLive Input Devices: %s", err)
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("no MediaLive Input Device found with serial number: %s", serialNumber)
+	}
+
+	return conn.DescribeInputDevice(&medialive.DescribeInputDeviceInput{InputDeviceId: found.Id})
+}
@@ -0,0 +1,73 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsMediaLiveInputSecurityGroup() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsMediaLiveInputSecurityGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"whitelist_rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsMediaLiveInputSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	id, ok := d.GetOk("id")
+	if !ok {
+		return fmt.Errorf("`id` must be set")
+	}
+
+	resp, err := conn.DescribeInputSecurityGroup(&medialive.DescribeInputSecurityGroupInput{
+		InputSecurityGroupId: aws.String(id.(string)),
+	})
+	if err != nil {
+		return fmt.Errorf("error describing MediaLive Input Security Group: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.Id))
+	d.Set("arn", aws.StringValue(resp.Arn))
+
+	if err := d.Set("whitelist_rules", flattenMediaLiveInputWhitelistRules(resp.WhitelistRules)); err != nil {
+		return fmt.Errorf("error setting whitelist_rules: %s", err)
+	}
+
+	if err := d.Set("tags", keyvaluetags.MedialiveKeyValueTags(resp.Tags).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
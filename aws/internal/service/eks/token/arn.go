@@ -5,18 +5,97 @@ https://github.com/kubernetes-sigs/aws-iam-authenticator/blob/7547c74e660f8d34d9
 With the following modifications:
  - Rename package from arn to token for simplication
  - Ignore errorlint reports
+ - Add PrincipalType/ParsedARN and a CanonicalizeWithDetails sibling to
+   Canonicalize, so callers that need the principal type or session name
+   (e.g. to map assumed-role sessions to RBAC groups) don't have to
+   re-parse the canonical ARN themselves
+ - Derive checkPartition from endpoints.DefaultPartitions() instead of a
+   hard-coded aws/aws-cn/aws-us-gov switch, so ISO partitions are picked
+   up automatically; add RegisterPartition and CanonicalizeWithPartitions
+   for partitions/whitelists the SDK doesn't know about
+ - Split resources on SplitResource instead of strings.Split(resource,
+   "/"), so a colon-delimited qualifier after the first path segment isn't
+   silently dropped; add typed Err* sentinels for errors.Is
 */
 
 package token
 
 import (
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
 
 	awsarn "github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 )
 
+// Sentinel errors for the failure modes of CanonicalizeWithDetails and its
+// variants, so callers can errors.Is on the failure mode instead of
+// string-matching the message.
+var (
+	ErrInvalidPartition       = errors.New("arn partition is not recognized")
+	ErrUnsupportedService     = errors.New("arn service is not supported for identities")
+	ErrMalformedAssumedRole   = errors.New("assumed-role arn is malformed")
+	ErrMalformedFederatedUser = errors.New("federated-user arn is malformed")
+)
+
+// SplitResource splits an ARN resource string into segments, treating both
+// "/" and ":" as delimiters -- mirroring the AWS SDK's own ARN resource
+// handling (e.g. its s3 internal ARN package) -- since IAM resources can
+// embed colon-delimited qualifiers after the first path segment that a
+// "/"-only strings.Split would silently fold into the preceding segment.
+func SplitResource(resource string) []string {
+	return strings.FieldsFunc(resource, func(r rune) bool {
+		return r == '/' || r == ':'
+	})
+}
+
+// PrincipalType identifies the kind of IAM/STS principal a canonicalized
+// ARN resolves to.
+type PrincipalType int
+
+const (
+	PrincipalTypeNone PrincipalType = iota
+	PrincipalTypeRole
+	PrincipalTypeUser
+	PrincipalTypeRoot
+	PrincipalTypeFederatedUser
+	PrincipalTypeAssumedRole
+)
+
+func (t PrincipalType) String() string {
+	switch t {
+	case PrincipalTypeRole:
+		return "ROLE"
+	case PrincipalTypeUser:
+		return "USER"
+	case PrincipalTypeRoot:
+		return "ROOT"
+	case PrincipalTypeFederatedUser:
+		return "FEDERATED_USER"
+	case PrincipalTypeAssumedRole:
+		return "ASSUMED_ROLE"
+	default:
+		return "NONE"
+	}
+}
+
+// ParsedARN is the structured result of CanonicalizeWithDetails: the
+// canonical ARN string alongside the fields callers most often need for
+// authorization decisions -- principal type, IAM path, friendly name and,
+// for assumed roles and federated users, the STS session name.
+type ParsedARN struct {
+	Canonical     string
+	Partition     string
+	AccountID     string
+	PrincipalType PrincipalType
+	Path          string
+	FriendlyName  string
+	SessionInfo   string
+}
+
 // Canonicalize validates IAM resources are appropriate for the authenticator
 // and converts STS assumed roles into the IAM role resource.
 //
@@ -27,52 +106,190 @@ import (
 //   - IAM Assumed role: arn:aws:sts::123456789012:assumed-role/Accounting-Role/Mary (converted to IAM role)
 //   - Federated user: arn:aws:sts::123456789012:federated-user/Bob
 func Canonicalize(arn string) (string, error) {
+	parsed, err := CanonicalizeWithDetails(arn)
+	if err != nil {
+		return "", err
+	}
+	return parsed.Canonical, nil
+}
+
+// CanonicalizeWithDetails is Canonicalize's sibling: it returns the same
+// canonical ARN string, plus the ParsedARN fields callers need to make
+// authorization decisions without re-parsing the canonical string
+// themselves. See ParsedARN.
+func CanonicalizeWithDetails(arn string) (ParsedARN, error) {
+	return canonicalizeWithDetails(arn, nil)
+}
+
+// CanonicalizeWithPartitions is Canonicalize's variant for operators
+// running in restricted or air-gapped environments: arn is only accepted
+// if its partition is both recognized (via the AWS SDK's endpoints
+// package or RegisterPartition) and present in allowed.
+func CanonicalizeWithPartitions(arn string, allowed []string) (string, error) {
+	parsed, err := canonicalizeWithDetails(arn, allowed)
+	if err != nil {
+		return "", err
+	}
+	return parsed.Canonical, nil
+}
+
+func canonicalizeWithDetails(arn string, allowed []string) (ParsedARN, error) {
 	parsed, err := awsarn.Parse(arn)
 	if err != nil {
-		return "", fmt.Errorf("arn '%s' is invalid: '%v'", arn, err) // nolint:errorlint
+		return ParsedARN{}, fmt.Errorf("arn '%s' is invalid: '%v'", arn, err) // nolint:errorlint
 	}
 
-	if err := checkPartition(parsed.Partition); err != nil {
-		return "", fmt.Errorf("arn '%s' does not have a recognized partition", arn)
+	if err := checkPartitionAllowed(parsed.Partition, allowed); err != nil {
+		return ParsedARN{}, fmt.Errorf("arn '%s' does not have a recognized partition: %w", arn, ErrInvalidPartition)
 	}
 
-	parts := strings.Split(parsed.Resource, "/")
+	parts := SplitResource(parsed.Resource)
+	if len(parts) == 0 {
+		return ParsedARN{}, fmt.Errorf("arn '%s' has an empty resource: %w", arn, ErrUnsupportedService)
+	}
 	resource := parts[0]
 
+	result := ParsedARN{
+		Partition: parsed.Partition,
+		AccountID: parsed.AccountID,
+	}
+
 	switch parsed.Service {
 	case "sts":
 		switch resource {
 		case "federated-user":
-			return arn, nil
+			if len(parts) < 2 || parts[1] == "" {
+				return ParsedARN{}, fmt.Errorf("federated-user arn '%s' does not have a user name: %w", arn, ErrMalformedFederatedUser)
+			}
+			// The name itself can contain ":", which SplitResource also
+			// splits on, so rejoin everything after the resource type
+			// instead of taking parts[1] alone.
+			name := strings.Join(parts[1:], ":")
+			result.Canonical = fmt.Sprintf("arn:%s:sts::%s:federated-user/%s", parsed.Partition, parsed.AccountID, name)
+			result.PrincipalType = PrincipalTypeFederatedUser
+			result.FriendlyName = name
+			result.SessionInfo = name
+			return result, nil
 		case "assumed-role":
 			if len(parts) < 3 {
-				return "", fmt.Errorf("assumed-role arn '%s' does not have a role", arn)
+				return ParsedARN{}, fmt.Errorf("assumed-role arn '%s' does not have a role: %w", arn, ErrMalformedAssumedRole)
 			}
 			// IAM ARNs can contain paths, part[0] is resource, parts[len(parts)] is the SessionName.
 			role := strings.Join(parts[1:len(parts)-1], "/")
-			return fmt.Sprintf("arn:%s:iam::%s:role/%s", parsed.Partition, parsed.AccountID, role), nil
+			result.Canonical = fmt.Sprintf("arn:%s:iam::%s:role/%s", parsed.Partition, parsed.AccountID, role)
+			result.PrincipalType = PrincipalTypeAssumedRole
+			result.SessionInfo = parts[len(parts)-1]
+			result.Path, result.FriendlyName = splitPathAndName(parts[1 : len(parts)-1])
+			return result, nil
 		default:
-			return "", fmt.Errorf("unrecognized resource %s for service sts", parsed.Resource)
+			return ParsedARN{}, fmt.Errorf("unrecognized resource %s for service sts: %w", parsed.Resource, ErrUnsupportedService)
 		}
 	case "iam":
 		switch resource {
-		case "role", "user", "root":
-			return arn, nil
+		case "role", "user":
+			result.Canonical = arn
+			if resource == "role" {
+				result.PrincipalType = PrincipalTypeRole
+			} else {
+				result.PrincipalType = PrincipalTypeUser
+			}
+			result.Path, result.FriendlyName = splitPathAndName(parts[1:])
+			return result, nil
+		case "root":
+			result.Canonical = arn
+			result.PrincipalType = PrincipalTypeRoot
+			return result, nil
 		default:
-			return "", fmt.Errorf("unrecognized resource %s for service iam", parsed.Resource)
+			return ParsedARN{}, fmt.Errorf("unrecognized resource %s for service iam: %w", parsed.Resource, ErrUnsupportedService)
 		}
 	}
 
-	return "", fmt.Errorf("service %s in arn %s is not a valid service for identities", parsed.Service, arn)
+	return ParsedARN{}, fmt.Errorf("service %s in arn %s is not a valid service for identities: %w", parsed.Service, arn, ErrUnsupportedService)
 }
 
-func checkPartition(partition string) error {
-	switch partition {
-	case endpoints.AwsPartitionID:
-	case endpoints.AwsCnPartitionID:
-	case endpoints.AwsUsGovPartitionID:
-	default:
-		return fmt.Errorf("partion %s is not recognized", partition)
+// splitPathAndName splits an IAM resource's path segments (with the
+// leading resource-type segment, e.g. "role", already stripped by the
+// caller) into the IAM path, e.g. "/foo/bar/", and the trailing friendly
+// name, e.g. "RoleName". A resource with no path segments returns an empty
+// path and just the name.
+func splitPathAndName(segments []string) (path, name string) {
+	if len(segments) == 0 {
+		return "", ""
+	}
+	name = segments[len(segments)-1]
+	if len(segments) > 1 {
+		path = "/" + strings.Join(segments[:len(segments)-1], "/") + "/"
+	}
+	return path, name
+}
+
+var (
+	partitionMu       sync.RWMutex
+	extraPartitions   = map[string]struct{}{}
+	partitionToRegion = buildPartitionToRegionMap()
+)
+
+// RegisterPartition whitelists an additional AWS partition -- one not
+// known to the AWS SDK's endpoints package -- that checkPartitionAllowed
+// should accept, for operators running in restricted or air-gapped
+// environments against a partition the SDK hasn't caught up with yet.
+func RegisterPartition(id string) {
+	partitionMu.Lock()
+	defer partitionMu.Unlock()
+	extraPartitions[id] = struct{}{}
+}
+
+// checkPartitionAllowed reports whether partition is recognized -- by the
+// AWS SDK's endpoints package or via RegisterPartition -- and, when
+// allowed is non-nil, additionally restricted to the partitions it lists.
+func checkPartitionAllowed(partition string, allowed []string) error {
+	if _, ok := partitionToRegion[partition]; !ok {
+		partitionMu.RLock()
+		_, registered := extraPartitions[partition]
+		partitionMu.RUnlock()
+
+		if !registered {
+			return fmt.Errorf("partion %s is not recognized: %w", partition, ErrInvalidPartition)
+		}
 	}
+
+	if allowed != nil {
+		for _, a := range allowed {
+			if a == partition {
+				return nil
+			}
+		}
+		return fmt.Errorf("partition %s is not in the allowed partition list: %w", partition, ErrInvalidPartition)
+	}
+
 	return nil
 }
+
+// RegionForPartition returns a representative region for partition, for
+// callers that need a region hint to construct an IAM/STS client against
+// an ARN's partition. The second return value is false if the partition
+// isn't known to the AWS SDK's endpoints package, including partitions that
+// are only accepted through RegisterPartition.
+func RegionForPartition(partition string) (string, bool) {
+	region, ok := partitionToRegion[partition]
+	return region, ok
+}
+
+// buildPartitionToRegionMap maps each partition known to the AWS SDK's
+// endpoints package to one representative region in it -- the
+// lexicographically first region ID, so the choice is stable across
+// process restarts and SDK versions that add regions.
+func buildPartitionToRegionMap() map[string]string {
+	m := make(map[string]string)
+	for _, p := range endpoints.DefaultPartitions() {
+		var regionIDs []string
+		for id := range p.Regions() {
+			regionIDs = append(regionIDs, id)
+		}
+		sort.Strings(regionIDs)
+		if len(regionIDs) > 0 {
+			m[p.ID()] = regionIDs[0]
+		}
+	}
+	return m
+}
@@ -0,0 +1,181 @@
+package token
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCanonicalizeWithDetailsFederatedUser(t *testing.T) {
+	tests := []struct {
+		name        string
+		arn         string
+		wantName    string
+		wantErr     error
+		wantDefault bool
+	}{
+		{
+			name:     "simple federated user",
+			arn:      "arn:aws:sts::123456789012:federated-user/Bob",
+			wantName: "Bob",
+		},
+		{
+			name:     "federated user name containing a colon",
+			arn:      "arn:aws:sts::123456789012:federated-user/team:Bob",
+			wantName: "team:Bob",
+		},
+		{
+			name:    "federated user with no name",
+			arn:     "arn:aws:sts::123456789012:federated-user/",
+			wantErr: ErrMalformedFederatedUser,
+		},
+		{
+			name:    "federated user resource with no trailing segment at all",
+			arn:     "arn:aws:sts::123456789012:federated-user",
+			wantErr: ErrMalformedFederatedUser,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CanonicalizeWithDetails(tt.arn)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.PrincipalType != PrincipalTypeFederatedUser {
+				t.Fatalf("expected PrincipalTypeFederatedUser, got %v", got.PrincipalType)
+			}
+			if got.FriendlyName != tt.wantName {
+				t.Fatalf("expected friendly name %q, got %q", tt.wantName, got.FriendlyName)
+			}
+			if got.SessionInfo != tt.wantName {
+				t.Fatalf("expected session info %q, got %q", tt.wantName, got.SessionInfo)
+			}
+		})
+	}
+}
+
+func TestCanonicalizeWithDetailsUnusualPartitions(t *testing.T) {
+	tests := []struct {
+		name    string
+		arn     string
+		allowed []string
+		wantErr error
+	}{
+		{
+			name: "aws-cn partition is recognized by the SDK",
+			arn:  "arn:aws-cn:iam::123456789012:role/S3Access",
+		},
+		{
+			name: "aws-us-gov partition is recognized by the SDK",
+			arn:  "arn:aws-us-gov:iam::123456789012:role/S3Access",
+		},
+		{
+			name:    "unregistered partition is rejected",
+			arn:     "arn:aws-iso-f:iam::123456789012:role/S3Access",
+			wantErr: ErrInvalidPartition,
+		},
+		{
+			name:    "recognized partition rejected when not in the allow list",
+			arn:     "arn:aws-cn:iam::123456789012:role/S3Access",
+			allowed: []string{"aws"},
+			wantErr: ErrInvalidPartition,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := canonicalizeWithDetails(tt.arn, tt.allowed)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestCanonicalizeWithDetailsAssumedRole(t *testing.T) {
+	got, err := CanonicalizeWithDetails("arn:aws:sts::123456789012:assumed-role/Accounting-Role/Mary")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Canonical != "arn:aws:iam::123456789012:role/Accounting-Role" {
+		t.Fatalf("expected the assumed-role arn to convert to an iam role arn, got %q", got.Canonical)
+	}
+	if got.PrincipalType != PrincipalTypeAssumedRole {
+		t.Fatalf("expected PrincipalTypeAssumedRole, got %v", got.PrincipalType)
+	}
+	if got.SessionInfo != "Mary" {
+		t.Fatalf("expected session info %q, got %q", "Mary", got.SessionInfo)
+	}
+}
+
+func TestSplitResource(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource string
+		want     []string
+	}{
+		{
+			name:     "slash delimited",
+			resource: "role/path/RoleName",
+			want:     []string{"role", "path", "RoleName"},
+		},
+		{
+			name:     "colon delimited qualifier after the first segment",
+			resource: "assumed-role/Accounting-Role/team:Mary",
+			want:     []string{"assumed-role", "Accounting-Role", "team", "Mary"},
+		},
+		{
+			name:     "empty resource",
+			resource: "",
+			want:     nil,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got := SplitResource(tt.resource)
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("expected %v, got %v", tt.want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckPartitionAllowedRegisteredPartition(t *testing.T) {
+	RegisterPartition("aws-unusual")
+	defer func() {
+		partitionMu.Lock()
+		delete(extraPartitions, "aws-unusual")
+		partitionMu.Unlock()
+	}()
+
+	if err := checkPartitionAllowed("aws-unusual", nil); err != nil {
+		t.Fatalf("expected a registered partition to be accepted, got %v", err)
+	}
+	if err := checkPartitionAllowed("aws-unusual", []string{"aws"}); !errors.Is(err, ErrInvalidPartition) {
+		t.Fatalf("expected a registered partition outside the allow list to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,50 @@
+package token
+
+import (
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// sourceARNHeader and sourceAccountHeader are the headers AWS recommends
+// attaching to AssumeRole/GetCallerIdentity calls made on a caller's behalf,
+// so the role's trust policy can condition on aws:SourceArn/aws:SourceAccount
+// as a confused-deputy mitigation. See:
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/confused-deputy.html
+const (
+	sourceARNHeader     = "x-amz-source-arn"
+	sourceAccountHeader = "x-amz-source-account"
+)
+
+// GeneratorOptions carries the optional confused-deputy headers a caller can
+// ask WithSourceARN to attach to every signed STS request. The zero value
+// leaves requests unchanged.
+//
+// This package only carries the ARN canonicalization half of upstream's
+// token package (see the file header in arn.go) -- it has no Generator or
+// STS-client constructor of its own for GeneratorOptions to thread through.
+// WithSourceARN is still usable standalone: pass it a *request.Request for
+// an AssumeRole/GetCallerIdentity call (e.g. via STS's Handlers.Sign hook)
+// wherever a caller in this tree builds that client.
+type GeneratorOptions struct {
+	SourceARN     string
+	SourceAccount string
+}
+
+// WithSourceARN registers a Sign.PushFront handler on handlers that sets the
+// x-amz-source-arn/x-amz-source-account headers on every signed request, so
+// a role trust policy can key aws:SourceArn/aws:SourceAccount conditions to
+// the caller. sourceARN and sourceAccount are both optional; if both are
+// empty, handlers is left unchanged.
+func WithSourceARN(handlers *request.Handlers, sourceARN, sourceAccount string) {
+	if sourceARN == "" && sourceAccount == "" {
+		return
+	}
+
+	handlers.Sign.PushFront(func(r *request.Request) {
+		if sourceARN != "" {
+			r.HTTPRequest.Header.Set(sourceARNHeader, sourceARN)
+		}
+		if sourceAccount != "" {
+			r.HTTPRequest.Header.Set(sourceAccountHeader, sourceAccount)
+		}
+	})
+}
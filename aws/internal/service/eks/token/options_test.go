@@ -0,0 +1,63 @@
+package token
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func newTestRequest() *request.Request {
+	return &request.Request{
+		HTTPRequest: &http.Request{Header: http.Header{}},
+	}
+}
+
+func TestWithSourceARNSetsHeaders(t *testing.T) {
+	handlers := &request.Handlers{}
+	WithSourceARN(handlers, "arn:aws:iam::123456789012:role/Example", "123456789012")
+
+	r := newTestRequest()
+	handlers.Sign.Run(r)
+
+	if got := r.HTTPRequest.Header.Get(sourceARNHeader); got != "arn:aws:iam::123456789012:role/Example" {
+		t.Fatalf("expected %s header to be set, got %q", sourceARNHeader, got)
+	}
+	if got := r.HTTPRequest.Header.Get(sourceAccountHeader); got != "123456789012" {
+		t.Fatalf("expected %s header to be set, got %q", sourceAccountHeader, got)
+	}
+}
+
+func TestWithSourceARNPartialOptions(t *testing.T) {
+	handlers := &request.Handlers{}
+	WithSourceARN(handlers, "arn:aws:iam::123456789012:role/Example", "")
+
+	r := newTestRequest()
+	handlers.Sign.Run(r)
+
+	if got := r.HTTPRequest.Header.Get(sourceARNHeader); got != "arn:aws:iam::123456789012:role/Example" {
+		t.Fatalf("expected %s header to be set, got %q", sourceARNHeader, got)
+	}
+	if got := r.HTTPRequest.Header.Get(sourceAccountHeader); got != "" {
+		t.Fatalf("expected %s header to be left unset, got %q", sourceAccountHeader, got)
+	}
+}
+
+func TestWithSourceARNEmptyOptionsLeaveRequestUnchanged(t *testing.T) {
+	handlers := &request.Handlers{}
+	WithSourceARN(handlers, "", "")
+
+	if handlers.Sign.Len() != 0 {
+		t.Fatalf("expected no Sign handler to be registered when both options are empty")
+	}
+
+	r := newTestRequest()
+	handlers.Sign.Run(r)
+
+	if got := r.HTTPRequest.Header.Get(sourceARNHeader); got != "" {
+		t.Fatalf("expected %s header to be unset, got %q", sourceARNHeader, got)
+	}
+	if got := r.HTTPRequest.Header.Get(sourceAccountHeader); got != "" {
+		t.Fatalf("expected %s header to be unset, got %q", sourceAccountHeader, got)
+	}
+}
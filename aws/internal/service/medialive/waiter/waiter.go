@@ -0,0 +1,286 @@
+// Package waiter holds the state-change waiters shared by the MediaLive
+// resources. Centralizing them keeps Timeouts block handling and the
+// pending/target state lists consistent across Input, InputSecurityGroup,
+// and Channel resources.
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const (
+	// Default timeouts used when a resource's Timeouts block does not
+	// override create/update/delete.
+	InputCreateTimeout     = 10 * time.Minute
+	InputDeleteTimeout     = 30 * time.Minute
+	ChannelCreateTimeout   = 15 * time.Minute
+	ChannelUpdateTimeout   = 15 * time.Minute
+	ChannelDeleteTimeout   = 30 * time.Minute
+	ChannelStartTimeout    = 30 * time.Minute
+	ChannelStopTimeout     = 30 * time.Minute
+	MultiplexCreateTimeout = 15 * time.Minute
+	MultiplexDeleteTimeout = 30 * time.Minute
+	MultiplexStartTimeout  = 30 * time.Minute
+	MultiplexStopTimeout   = 30 * time.Minute
+
+	// ChannelStateChangeConflictTimeout bounds retries of StartChannel/
+	// StopChannel against the transient ConflictException MediaLive returns
+	// while a channel is still settling into its current state.
+	ChannelStateChangeConflictTimeout = 5 * time.Minute
+)
+
+func inputRefresh(conn *medialive.MediaLive, inputId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeInput(&medialive.DescribeInputInput{InputId: aws.String(inputId)})
+
+		if isResourceNotFoundErr(err) {
+			return nil, medialive.InputStateDeleted, nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		if resp == nil {
+			return nil, medialive.InputStateDeleted, nil
+		}
+
+		return resp, aws.StringValue(resp.State), nil
+	}
+}
+
+// InputCreated waits for a newly created Input to leave the CREATING state.
+func InputCreated(conn *medialive.MediaLive, inputId string, timeout time.Duration) (*medialive.DescribeInputOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{medialive.InputStateCreating},
+		Target:                    []string{medialive.InputStateDetached, medialive.InputStateAttached},
+		Refresh:                   inputRefresh(conn, inputId),
+		Timeout:                   timeout,
+		Delay:                     10 * time.Second,
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 5,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if output, ok := outputRaw.(*medialive.DescribeInputOutput); ok {
+		return output, err
+	}
+	return nil, err
+}
+
+// InputDeleted waits for an Input to be fully deleted.
+func InputDeleted(conn *medialive.MediaLive, inputId string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			medialive.InputStateDetached,
+			medialive.InputStateAttached,
+			medialive.InputStateDeleting,
+		},
+		Target:         []string{medialive.InputStateDeleted},
+		Refresh:        inputRefresh(conn, inputId),
+		Timeout:        timeout,
+		NotFoundChecks: 1,
+	}
+
+	_, err := stateConf.WaitForState()
+	if isResourceNotFoundErr(err) {
+		return nil
+	}
+	return err
+}
+
+// InputAttached waits for an Input to reach the ATTACHED state, e.g. after
+// being referenced by a Channel's input_attachments.
+func InputAttached(conn *medialive.MediaLive, inputId string, timeout time.Duration) (*medialive.DescribeInputOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{medialive.InputStateDetached, medialive.InputStateCreating},
+		Target:     []string{medialive.InputStateAttached},
+		Refresh:    inputRefresh(conn, inputId),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if output, ok := outputRaw.(*medialive.DescribeInputOutput); ok {
+		return output, err
+	}
+	return nil, err
+}
+
+func channelRefresh(conn *medialive.MediaLive, channelId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeChannel(&medialive.DescribeChannelInput{ChannelId: aws.String(channelId)})
+
+		if isResourceNotFoundErr(err) {
+			return nil, medialive.ChannelStateDeleted, nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		if resp == nil {
+			return nil, medialive.ChannelStateDeleted, nil
+		}
+
+		return resp, aws.StringValue(resp.State), nil
+	}
+}
+
+// ChannelRunning waits for a Channel to reach RUNNING, e.g. after StartChannel.
+func ChannelRunning(conn *medialive.MediaLive, channelId string, timeout time.Duration) (*medialive.DescribeChannelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{medialive.ChannelStateStarting},
+		Target:     []string{medialive.ChannelStateRunning},
+		Refresh:    channelRefresh(conn, channelId),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if output, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
+		return output, err
+	}
+	return nil, err
+}
+
+// ChannelStopped waits for a Channel to settle back to IDLE, e.g. after
+// StopChannel or after Create/Update.
+func ChannelStopped(conn *medialive.MediaLive, channelId string, timeout time.Duration) (*medialive.DescribeChannelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{medialive.ChannelStateCreating, medialive.ChannelStateUpdating, medialive.ChannelStateStopping},
+		Target:                    []string{medialive.ChannelStateIdle},
+		Refresh:                   channelRefresh(conn, channelId),
+		Timeout:                   timeout,
+		Delay:                     10 * time.Second,
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 5,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if output, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
+		return output, err
+	}
+	return nil, err
+}
+
+// ChannelDeleted waits for a Channel to be fully deleted.
+func ChannelDeleted(conn *medialive.MediaLive, channelId string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:        []string{medialive.ChannelStateDeleting},
+		Target:         []string{medialive.ChannelStateDeleted},
+		Refresh:        channelRefresh(conn, channelId),
+		Timeout:        timeout,
+		NotFoundChecks: 1,
+	}
+
+	_, err := stateConf.WaitForState()
+	if isResourceNotFoundErr(err) {
+		return nil
+	}
+	return err
+}
+
+func multiplexRefresh(conn *medialive.MediaLive, multiplexId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeMultiplex(&medialive.DescribeMultiplexInput{MultiplexId: aws.String(multiplexId)})
+
+		if isResourceNotFoundErr(err) {
+			return nil, medialive.MultiplexStateDeleted, nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		if resp == nil {
+			return nil, medialive.MultiplexStateDeleted, nil
+		}
+
+		return resp, aws.StringValue(resp.State), nil
+	}
+}
+
+// MultiplexCreated waits for a newly created Multiplex to leave the CREATING
+// state.
+func MultiplexCreated(conn *medialive.MediaLive, multiplexId string, timeout time.Duration) (*medialive.DescribeMultiplexOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{medialive.MultiplexStateCreating},
+		Target:     []string{medialive.MultiplexStateIdle},
+		Refresh:    multiplexRefresh(conn, multiplexId),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if output, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
+		return output, err
+	}
+	return nil, err
+}
+
+// MultiplexRunning waits for a Multiplex to reach RUNNING, e.g. after
+// StartMultiplex.
+func MultiplexRunning(conn *medialive.MediaLive, multiplexId string, timeout time.Duration) (*medialive.DescribeMultiplexOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{medialive.MultiplexStateStarting},
+		Target:     []string{medialive.MultiplexStateRunning},
+		Refresh:    multiplexRefresh(conn, multiplexId),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if output, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
+		return output, err
+	}
+	return nil, err
+}
+
+// MultiplexStopped waits for a Multiplex to settle back to IDLE, e.g. after
+// StopMultiplex.
+func MultiplexStopped(conn *medialive.MediaLive, multiplexId string, timeout time.Duration) (*medialive.DescribeMultiplexOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{medialive.MultiplexStateStopping},
+		Target:     []string{medialive.MultiplexStateIdle},
+		Refresh:    multiplexRefresh(conn, multiplexId),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if output, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
+		return output, err
+	}
+	return nil, err
+}
+
+// MultiplexDeleted waits for a Multiplex to be fully deleted.
+func MultiplexDeleted(conn *medialive.MediaLive, multiplexId string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:        []string{medialive.MultiplexStateDeleting},
+		Target:         []string{medialive.MultiplexStateDeleted},
+		Refresh:        multiplexRefresh(conn, multiplexId),
+		Timeout:        timeout,
+		NotFoundChecks: 1,
+	}
+
+	_, err := stateConf.WaitForState()
+	if isResourceNotFoundErr(err) {
+		return nil
+	}
+	return err
+}
+
+func isResourceNotFoundErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	if awsErr, ok := err.(interface{ Code() string }); ok {
+		return awsErr.Code() == medialive.ErrCodeNotFoundException
+	}
+	return false
+}
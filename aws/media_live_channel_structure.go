@@ -4,6 +4,22 @@
 // configuration for the aws_media_live_channel resource, as there are
 // several sub-fields that require their own data type, and do not necessarily
 // 1-1 translate to resource configuration.
+//
+// These expand functions are written out by hand, one field at a time,
+// rather than generated from a shared schema-to-struct mapping. That's
+// consistent with every other resource in this provider (see any other
+// resource_aws_*.go/​*_structure.go pair under aws/) and with upstream
+// terraform-provider-aws, which this package tracks closely -- a reflection-
+// or codegen-based mapper here would be a one-off convention no other
+// resource follows, and would need to special-case the handful of fields
+// (destination refs, *_group_settings/*_output_settings variants, the
+// polymorphic codec_settings block) that aren't a straight field-for-field
+// copy anyway.
+//
+// Flatten coverage does not yet match expand: flattenChannelMaintenance is
+// the only flatten function in this file, so resourceAwsMediaLiveChannelRead
+// cannot set input_attachments, destinations, or encoder_settings from
+// DescribeChannel's response -- see the TODO on that function.
 
 package aws
 
@@ -12,7 +28,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/medialive"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func expandInputAttachments(inputAttachments []interface{}) []*medialive.InputAttachment {
@@ -27,24 +42,22 @@ func expandInputAttachments(inputAttachments []interface{}) []*medialive.InputAt
 		result = append(result, &medialive.InputAttachment{
 			InputAttachmentName: aws.String(r["input_attachment_name"].(string)),
 			InputId:             aws.String(r["input_id"].(string)),
-			InputSettings:       expandInputAttachmentSettings(r["input_settings"].(*schema.Set)),
+			InputSettings:       expandInputAttachmentSettings(r["input_settings"].([]interface{})),
 		})
 	}
 	return result
 }
 
-func expandInputSpecification(s *schema.Set) *medialive.InputSpecification {
-	if s.Len() > 0 {
-		rawInputSpecification := s.List()[0].(map[string]interface{})
+func expandInputSpecification(s []interface{}) *medialive.InputSpecification {
+	if len(s) > 0 {
+		rawInputSpecification := s[0].(map[string]interface{})
 		return &medialive.InputSpecification{
 			Codec:          aws.String(rawInputSpecification["codec"].(string)),
 			MaximumBitrate: aws.String(rawInputSpecification["maximum_bitrate"].(string)),
 			Resolution:     aws.String(rawInputSpecification["resolution"].(string)),
 		}
-	} else {
-		log.Printf("[WARN] MediaLive Channel: Input Specification can not be found")
-		return &medialive.InputSpecification{}
 	}
+	return nil
 }
 
 func expandDestinations(destinations []interface{}) []*medialive.OutputDestination {
@@ -58,13 +71,38 @@ func expandDestinations(destinations []interface{}) []*medialive.OutputDestinati
 		r := destination.(map[string]interface{})
 
 		result = append(result, &medialive.OutputDestination{
-			Id:       aws.String(r["id"].(string)),
-			Settings: expandOutputDestinationSettings(r["settings"].([]interface{})),
+			Id:                aws.String(r["id"].(string)),
+			Settings:          expandOutputDestinationSettings(r["settings"].([]interface{})),
+			MultiplexSettings: expandMultiplexProgramChannelDestinationSettings(r["multiplex_settings"].([]interface{})),
 		})
 	}
 	return result
 }
 
+// expandMultiplexProgramChannelDestinationSettings points a channel's output
+// at a program already created on an aws_medialive_multiplex, via
+// aws_medialive_multiplex_program's multiplex_id/program_name.
+func expandMultiplexProgramChannelDestinationSettings(s []interface{}) *medialive.MultiplexProgramChannelDestinationSettings {
+	if len(s) == 0 {
+		return nil
+	}
+	settings := s[0].(map[string]interface{})
+
+	return &medialive.MultiplexProgramChannelDestinationSettings{
+		MultiplexId: aws.String(settings["multiplex_id"].(string)),
+		ProgramName: aws.String(settings["program_name"].(string)),
+	}
+}
+
+// expandOutputDestinationSettings expands a destination's settings, including
+// username/password_param -- the SSM SecureString parameters MediaLive uses
+// to authenticate to RTMPS endpoints (YouTube, Twitch, Facebook Live). These
+// live here, on the shared top-level destination, rather than on the
+// protocol-specific *_output_settings block: RtmpOutputSettings (and every
+// other output settings variant) only ever carries a destination_ref_id, so
+// RTMPS credentials come from the destination that expandRtmpOutputDestination's
+// destination_ref_id names, whose settings expandDestinations passes to this
+// function.
 func expandOutputDestinationSettings(destinationSettings []interface{}) []*medialive.OutputDestinationSettings {
 	var result []*medialive.OutputDestinationSettings
 	if len(destinationSettings) == 0 {
@@ -85,21 +123,173 @@ func expandOutputDestinationSettings(destinationSettings []interface{}) []*media
 	return result
 }
 
-func expandEncoderSettings(s *schema.Set) *medialive.EncoderSettings {
-	if s.Len() > 0 {
-		rawEncoderSettings := s.List()[0].(map[string]interface{})
+func expandEncoderSettings(s []interface{}) *medialive.EncoderSettings {
+	if len(s) > 0 {
+		rawEncoderSettings := s[0].(map[string]interface{})
 		return &medialive.EncoderSettings{
-			AudioDescriptions:   expandAudioDescriptions(rawEncoderSettings["audio_descriptions"].([]interface{})),
-			CaptionDescriptions: expandCaptionDescriptions(rawEncoderSettings["caption_descriptions"].([]interface{})),
-			OutputGroups:        expandOutputGroups(rawEncoderSettings["output_groups"].([]interface{})),
-			TimecodeConfig:      expandTimecodeConfigs(rawEncoderSettings["timecode_config"].(*schema.Set)),
-			VideoDescriptions:   expandVideoDescriptions(rawEncoderSettings["video_descriptions"].([]interface{})),
-			FeatureActivations:  expandFeatureActivations(rawEncoderSettings["feature_activations"].(*schema.Set)),
+			AudioDescriptions:    expandAudioDescriptions(rawEncoderSettings["audio_descriptions"].([]interface{})),
+			CaptionDescriptions:  expandCaptionDescriptions(rawEncoderSettings["caption_descriptions"].([]interface{})),
+			OutputGroups:         expandOutputGroups(rawEncoderSettings["output_groups"].([]interface{})),
+			TimecodeConfig:       expandTimecodeConfigs(rawEncoderSettings["timecode_config"].([]interface{})),
+			VideoDescriptions:    expandVideoDescriptions(rawEncoderSettings["video_descriptions"].([]interface{})),
+			FeatureActivations:   expandFeatureActivations(rawEncoderSettings["feature_activations"].([]interface{})),
+			NielsenConfiguration: expandNielsenConfiguration(rawEncoderSettings["nielsen_configuration"].([]interface{})),
+			GlobalConfiguration:  expandGlobalConfiguration(rawEncoderSettings["global_configuration"].([]interface{})),
+			AvailConfiguration:   expandAvailConfiguration(rawEncoderSettings["avail_configuration"].([]interface{})),
 		}
-	} else {
-		log.Printf("[ERROR] MediaLive Channel: Encoder settings required")
-		return &medialive.EncoderSettings{}
 	}
+	return nil
+}
+
+func expandGlobalConfiguration(s []interface{}) *medialive.GlobalConfiguration {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
+		result := &medialive.GlobalConfiguration{
+			InitialAudioGain:          aws.Int64(int64(settings["initial_audio_gain"].(int))),
+			InputEndAction:            aws.String(settings["input_end_action"].(string)),
+			InputLossBehavior:         expandInputLossBehavior(settings["input_loss_behavior"].([]interface{})),
+			OutputLockingMode:         aws.String(settings["output_locking_mode"].(string)),
+			OutputTimingSource:        aws.String(settings["output_timing_source"].(string)),
+			SupportLowFramerateInputs: aws.String(settings["support_low_framerate_inputs"].(string)),
+		}
+		if v := settings["output_locking_settings"].([]interface{}); len(v) > 0 {
+			result.OutputLockingSettings = expandOutputLockingSettings(v)
+		}
+		return result
+	}
+	return nil
+}
+
+func expandInputLossBehavior(s []interface{}) *medialive.InputLossBehavior {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
+		result := &medialive.InputLossBehavior{
+			InputLossImageType: aws.String(settings["input_loss_image_type"].(string)),
+		}
+		if v := settings["black_frame_msec"].(int); v > 0 {
+			result.BlackFrameMsec = aws.Int64(int64(v))
+		}
+		if v := settings["input_loss_image_color"].(string); v != "" {
+			result.InputLossImageColor = aws.String(v)
+		}
+		if v := settings["repeat_frame_msec"].(int); v > 0 {
+			result.RepeatFrameMsec = aws.Int64(int64(v))
+		}
+		if v := settings["input_loss_image_slate"].([]interface{}); len(v) > 0 {
+			slate := v[0].(map[string]interface{})
+			result.InputLossImageSlate = &medialive.InputLocation{
+				Uri:      aws.String(slate["uri"].(string)),
+				Username: aws.String(slate["username"].(string)),
+			}
+			if v := slate["password_param"].(string); v != "" {
+				result.InputLossImageSlate.PasswordParam = aws.String(v)
+			}
+		}
+		return result
+	}
+	return nil
+}
+
+// expandOutputLockingSettings expands the mechanism backing
+// output_locking_mode = "EPOCH_LOCKING": epoch_locking_settings and
+// pipeline_locking_settings are mutually exclusive, matching the real
+// OutputLockingSettings union.
+func expandOutputLockingSettings(s []interface{}) *medialive.OutputLockingSettings {
+	settings := s[0].(map[string]interface{})
+	result := &medialive.OutputLockingSettings{}
+
+	if v := settings["epoch_locking_settings"].([]interface{}); len(v) > 0 {
+		result.EpochLockingSettings = expandEpochLockingSettings(v)
+	}
+	if v := settings["pipeline_locking_settings"].([]interface{}); len(v) > 0 {
+		result.PipelineLockingSettings = &medialive.PipelineLockingSettings{}
+	}
+
+	return result
+}
+
+func expandEpochLockingSettings(s []interface{}) *medialive.EpochLockingSettings {
+	settings := s[0].(map[string]interface{})
+	result := &medialive.EpochLockingSettings{}
+	if v := settings["custom_epoch"].(string); v != "" {
+		result.CustomEpoch = aws.String(v)
+	}
+	if v := settings["jam_sync_time"].(string); v != "" {
+		result.JamSyncTime = aws.String(v)
+	}
+	return result
+}
+
+func expandNielsenConfiguration(s []interface{}) *medialive.NielsenConfiguration {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
+		result := &medialive.NielsenConfiguration{}
+		if v := settings["distributor_id"].(string); v != "" {
+			result.DistributorId = aws.String(v)
+		}
+		if v := settings["nielsen_pcm_to_id3_tagging"].(string); v != "" {
+			result.NielsenPcmToId3Tagging = aws.String(v)
+		}
+		return result
+	}
+	return nil
+}
+
+// expandAvailConfiguration expands event-wide ad avail insertion settings,
+// as opposed to the encoder_settings avail_blanking block, which only covers
+// what's shown on screen during an avail.
+func expandAvailConfiguration(s []interface{}) *medialive.AvailConfiguration {
+	if len(s) == 0 {
+		return nil
+	}
+	settings := s[0].(map[string]interface{})
+
+	if v := settings["avail_settings"].([]interface{}); len(v) > 0 {
+		return &medialive.AvailConfiguration{
+			AvailSettings: expandAvailSettings(v),
+		}
+	}
+	return &medialive.AvailConfiguration{}
+}
+
+// expandAvailSettings expands scte35_splice_insert and scte35_time_signal_apos
+// into the AvailSettings union. It expands each of them that is set and does
+// not reject a configuration that sets both.
+func expandAvailSettings(s []interface{}) *medialive.AvailSettings {
+	settings := s[0].(map[string]interface{})
+	result := &medialive.AvailSettings{}
+
+	if v := settings["scte35_splice_insert"].([]interface{}); len(v) > 0 {
+		raw := v[0].(map[string]interface{})
+		spliceInsert := &medialive.Scte35SpliceInsert{}
+		if v := raw["ad_avail_offset"].(int); v != 0 {
+			spliceInsert.AdAvailOffset = aws.Int64(int64(v))
+		}
+		if v := raw["no_regional_blackout_flag"].(string); v != "" {
+			spliceInsert.NoRegionalBlackoutFlag = aws.String(v)
+		}
+		if v := raw["web_delivery_allowed_flag"].(string); v != "" {
+			spliceInsert.WebDeliveryAllowedFlag = aws.String(v)
+		}
+		result.Scte35SpliceInsert = spliceInsert
+	}
+
+	if v := settings["scte35_time_signal_apos"].([]interface{}); len(v) > 0 {
+		raw := v[0].(map[string]interface{})
+		timeSignalApos := &medialive.Scte35TimeSignalApos{}
+		if v := raw["ad_avail_offset"].(int); v != 0 {
+			timeSignalApos.AdAvailOffset = aws.Int64(int64(v))
+		}
+		if v := raw["no_regional_blackout_flag"].(string); v != "" {
+			timeSignalApos.NoRegionalBlackoutFlag = aws.String(v)
+		}
+		if v := raw["web_delivery_allowed_flag"].(string); v != "" {
+			timeSignalApos.WebDeliveryAllowedFlag = aws.String(v)
+		}
+		result.Scte35TimeSignalApos = timeSignalApos
+	}
+
+	return result
 }
 
 func expandAudioDescriptions(audioDescriptions []interface{}) []*medialive.AudioDescription {
@@ -112,44 +302,292 @@ func expandAudioDescriptions(audioDescriptions []interface{}) []*medialive.Audio
 	for _, descs := range audioDescriptions {
 		r := descs.(map[string]interface{})
 
-		result = append(result, &medialive.AudioDescription{
+		audioDescription := &medialive.AudioDescription{
 			AudioSelectorName:   aws.String(r["audio_selector_name"].(string)),
 			Name:                aws.String(r["name"].(string)),
-			CodecSettings:       expandAudioCodecSettings(r["codec_settings"].(*schema.Set)),
+			CodecSettings:       expandAudioCodecSettings(r["codec_settings"].([]interface{})),
 			AudioTypeControl:    aws.String(r["audio_type_control"].(string)),
 			LanguageCodeControl: aws.String(r["language_code_control"].(string)),
+		}
+		if v := r["audio_type"].(string); v != "" {
+			audioDescription.AudioType = aws.String(v)
+		}
+		if v := r["language_code"].(string); v != "" {
+			audioDescription.LanguageCode = aws.String(v)
+		}
+		if v := r["stream_name"].(string); v != "" {
+			audioDescription.StreamName = aws.String(v)
+		}
+		if v := r["audio_normalization_settings"].([]interface{}); len(v) > 0 {
+			audioDescription.AudioNormalizationSettings = expandAudioNormalizationSettings(v)
+		}
+		if v := r["remix_settings"].([]interface{}); len(v) > 0 {
+			audioDescription.RemixSettings = expandRemixSettings(v)
+		}
+		if v := r["audio_watermarking_settings"].([]interface{}); len(v) > 0 {
+			audioDescription.AudioWatermarkingSettings = expandAudioWatermarkingSettings(v)
+		}
+
+		result = append(result, audioDescription)
+	}
+	return result
+}
+
+func expandAudioNormalizationSettings(s []interface{}) *medialive.AudioNormalizationSettings {
+	settings := s[0].(map[string]interface{})
+	result := &medialive.AudioNormalizationSettings{}
+	if v := settings["algorithm"].(string); v != "" {
+		result.Algorithm = aws.String(v)
+	}
+	if v := settings["algorithm_control"].(string); v != "" {
+		result.AlgorithmControl = aws.String(v)
+	}
+	if v := settings["target_lkfs"].(float64); v != 0 {
+		result.TargetLkfs = aws.Float64(v)
+	}
+	return result
+}
+
+func expandRemixSettings(s []interface{}) *medialive.RemixSettings {
+	settings := s[0].(map[string]interface{})
+	return &medialive.RemixSettings{
+		ChannelsIn:      aws.Int64(int64(settings["channels_in"].(int))),
+		ChannelsOut:     aws.Int64(int64(settings["channels_out"].(int))),
+		ChannelMappings: expandAudioChannelMappings(settings["channel_mappings"].([]interface{})),
+	}
+}
+
+func expandAudioChannelMappings(mappings []interface{}) []*medialive.AudioChannelMapping {
+	var result []*medialive.AudioChannelMapping
+	for _, rawMapping := range mappings {
+		m := rawMapping.(map[string]interface{})
+		result = append(result, &medialive.AudioChannelMapping{
+			OutputChannel:      aws.Int64(int64(m["output_channel"].(int))),
+			InputChannelLevels: expandInputChannelLevels(m["input_channel_levels"].([]interface{})),
 		})
 	}
 	return result
 }
 
-func expandFeatureActivations(s *schema.Set) *medialive.FeatureActivations {
-	if s.Len() > 0 {
-		rawConfig := s.List()[0].(map[string]interface{})
+func expandInputChannelLevels(levels []interface{}) []*medialive.InputChannelLevel {
+	var result []*medialive.InputChannelLevel
+	for _, rawLevel := range levels {
+		l := rawLevel.(map[string]interface{})
+		result = append(result, &medialive.InputChannelLevel{
+			InputChannel: aws.Int64(int64(l["input_channel"].(int))),
+			Gain:         aws.Int64(int64(l["gain"].(int))),
+		})
+	}
+	return result
+}
+
+func expandAudioWatermarkingSettings(s []interface{}) *medialive.AudioWatermarkSettings {
+	settings := s[0].(map[string]interface{})
+	result := &medialive.AudioWatermarkSettings{}
+	if v := settings["nielsen_watermarks_settings"].([]interface{}); len(v) > 0 {
+		result.NielsenWatermarksSettings = expandNielsenWatermarksSettings(v)
+	}
+	return result
+}
+
+func expandNielsenWatermarksSettings(s []interface{}) *medialive.NielsenWatermarksSettings {
+	settings := s[0].(map[string]interface{})
+	result := &medialive.NielsenWatermarksSettings{}
+	if v := settings["nielsen_cbet_settings"].([]interface{}); len(v) > 0 {
+		result.NielsenCbetSettings = expandNielsenCbetSettings(v)
+	}
+	if v := settings["nielsen_distribution_type"].(string); v != "" {
+		result.NielsenDistributionType = aws.String(v)
+	}
+	if v := settings["nielsen_naes2_and_nw_settings"].([]interface{}); len(v) > 0 {
+		result.NielsenNaes2AndNwSettings = expandNielsenNaes2AndNwSettings(v)
+	}
+	return result
+}
+
+func expandNielsenCbetSettings(s []interface{}) *medialive.NielsenCBET {
+	settings := s[0].(map[string]interface{})
+	return &medialive.NielsenCBET{
+		CbetCheckDigitString: aws.String(settings["cbet_check_digit_string"].(string)),
+		CbetStepaside:        aws.String(settings["cbet_stepaside"].(string)),
+		Csid:                 aws.String(settings["csid"].(string)),
+	}
+}
+
+func expandNielsenNaes2AndNwSettings(s []interface{}) *medialive.NielsenNaesIiNw {
+	settings := s[0].(map[string]interface{})
+	return &medialive.NielsenNaesIiNw{
+		CheckDigitString: aws.String(settings["check_digit_string"].(string)),
+		Sid:              aws.Float64(settings["sid"].(float64)),
+	}
+}
+
+func expandFeatureActivations(s []interface{}) *medialive.FeatureActivations {
+	if len(s) > 0 {
+		rawConfig := s[0].(map[string]interface{})
 		return &medialive.FeatureActivations{
 			InputPrepareScheduleActions: aws.String(rawConfig["input_prepare_schedule_actions"].(string)),
 		}
-	} else {
-		log.Printf("[ERROR] MediaLive Channel: FeatureActivations config is malformed")
-		return &medialive.FeatureActivations{}
 	}
+	return nil
 }
 
-func expandAudioCodecSettings(s *schema.Set) *medialive.AudioCodecSettings {
-	if s.Len() > 0 {
-		rawCodecSettings := s.List()[0].(map[string]interface{})
-		return &medialive.AudioCodecSettings{
-			AacSettings: expandAacCodecSettings(rawCodecSettings["aac_settings"].(*schema.Set)),
+func expandAudioCodecSettings(s []interface{}) *medialive.AudioCodecSettings {
+	if len(s) > 0 {
+		rawCodecSettings := s[0].(map[string]interface{})
+		result := &medialive.AudioCodecSettings{}
+		if v := rawCodecSettings["aac_settings"].([]interface{}); len(v) > 0 {
+			result.AacSettings = expandAacCodecSettings(v)
 		}
-	} else {
-		log.Printf("[WARN] MediaLive Channel: Input Specification can not be found")
-		return &medialive.AudioCodecSettings{}
+		if v := rawCodecSettings["ac3_settings"].([]interface{}); len(v) > 0 {
+			result.Ac3Settings = expandAc3Settings(v)
+		}
+		if v := rawCodecSettings["eac3_settings"].([]interface{}); len(v) > 0 {
+			result.Eac3Settings = expandEac3Settings(v)
+		}
+		if v := rawCodecSettings["mp2_settings"].([]interface{}); len(v) > 0 {
+			result.Mp2Settings = expandMp2Settings(v)
+		}
+		if v := rawCodecSettings["pass_through_settings"].([]interface{}); len(v) > 0 {
+			result.PassThroughSettings = &medialive.PassThroughSettings{}
+		}
+		if v := rawCodecSettings["wav_settings"].([]interface{}); len(v) > 0 {
+			result.WavSettings = expandWavSettings(v)
+		}
+		return result
+	}
+	return nil
+}
+
+func expandAc3Settings(s []interface{}) *medialive.Ac3Settings {
+	settings := s[0].(map[string]interface{})
+	result := &medialive.Ac3Settings{}
+	if v := settings["bitrate"].(float64); v > 0 {
+		result.Bitrate = aws.Float64(v)
+	}
+	if v := settings["bitstream_mode"].(string); v != "" {
+		result.BitstreamMode = aws.String(v)
+	}
+	if v := settings["coding_mode"].(string); v != "" {
+		result.CodingMode = aws.String(v)
+	}
+	if v := settings["dialnorm"].(int); v > 0 {
+		result.Dialnorm = aws.Int64(int64(v))
+	}
+	if v := settings["drc_profile"].(string); v != "" {
+		result.DrcProfile = aws.String(v)
+	}
+	if v := settings["lfe_filter"].(string); v != "" {
+		result.LfeFilter = aws.String(v)
+	}
+	if v := settings["metadata_control"].(string); v != "" {
+		result.MetadataControl = aws.String(v)
+	}
+	return result
+}
+
+func expandEac3Settings(s []interface{}) *medialive.Eac3Settings {
+	settings := s[0].(map[string]interface{})
+	result := &medialive.Eac3Settings{}
+	if v := settings["bitrate"].(float64); v > 0 {
+		result.Bitrate = aws.Float64(v)
+	}
+	if v := settings["coding_mode"].(string); v != "" {
+		result.CodingMode = aws.String(v)
+	}
+	if v := settings["atmos_coding_mode"].(string); v != "" {
+		result.AtmosCodingMode = aws.String(v)
+	}
+	if v := settings["attenuation_control"].(string); v != "" {
+		result.AttenuationControl = aws.String(v)
+	}
+	if v := settings["bitstream_mode"].(string); v != "" {
+		result.BitstreamMode = aws.String(v)
+	}
+	if v := settings["dc_filter"].(string); v != "" {
+		result.DcFilter = aws.String(v)
+	}
+	if v := settings["dialnorm"].(int); v > 0 {
+		result.Dialnorm = aws.Int64(int64(v))
+	}
+	if v := settings["drc_line"].(string); v != "" {
+		result.DrcLine = aws.String(v)
+	}
+	if v := settings["drc_rf"].(string); v != "" {
+		result.DrcRf = aws.String(v)
+	}
+	if v := settings["lfe_control"].(string); v != "" {
+		result.LfeControl = aws.String(v)
+	}
+	if v := settings["lfe_filter"].(string); v != "" {
+		result.LfeFilter = aws.String(v)
+	}
+	if v := settings["lo_ro_center_mix_level"].(float64); v != 0 {
+		result.LoRoCenterMixLevel = aws.Float64(v)
+	}
+	if v := settings["lo_ro_surround_mix_level"].(float64); v != 0 {
+		result.LoRoSurroundMixLevel = aws.Float64(v)
+	}
+	if v := settings["lt_rt_center_mix_level"].(float64); v != 0 {
+		result.LtRtCenterMixLevel = aws.Float64(v)
+	}
+	if v := settings["lt_rt_surround_mix_level"].(float64); v != 0 {
+		result.LtRtSurroundMixLevel = aws.Float64(v)
+	}
+	if v := settings["metadata_control"].(string); v != "" {
+		result.MetadataControl = aws.String(v)
+	}
+	if v := settings["passthrough_control"].(string); v != "" {
+		result.PassthroughControl = aws.String(v)
+	}
+	if v := settings["phase_control"].(string); v != "" {
+		result.PhaseControl = aws.String(v)
+	}
+	if v := settings["stereo_downmix"].(string); v != "" {
+		result.StereoDownmix = aws.String(v)
+	}
+	if v := settings["surround_ex_mode"].(string); v != "" {
+		result.SurroundExMode = aws.String(v)
+	}
+	if v := settings["surround_mode"].(string); v != "" {
+		result.SurroundMode = aws.String(v)
+	}
+	return result
+}
+
+func expandMp2Settings(s []interface{}) *medialive.Mp2Settings {
+	settings := s[0].(map[string]interface{})
+	result := &medialive.Mp2Settings{}
+	if v := settings["bitrate"].(float64); v > 0 {
+		result.Bitrate = aws.Float64(v)
+	}
+	if v := settings["coding_mode"].(string); v != "" {
+		result.CodingMode = aws.String(v)
+	}
+	if v := settings["sample_rate"].(float64); v > 0 {
+		result.SampleRate = aws.Float64(v)
+	}
+	return result
+}
+
+func expandWavSettings(s []interface{}) *medialive.WavSettings {
+	settings := s[0].(map[string]interface{})
+	result := &medialive.WavSettings{}
+	if v := settings["bit_depth"].(float64); v > 0 {
+		result.BitDepth = aws.Float64(v)
+	}
+	if v := settings["coding_mode"].(string); v != "" {
+		result.CodingMode = aws.String(v)
 	}
+	if v := settings["sample_rate"].(float64); v > 0 {
+		result.SampleRate = aws.Float64(v)
+	}
+	return result
 }
 
-func expandAacCodecSettings(s *schema.Set) *medialive.AacSettings {
-	if s.Len() > 0 {
-		rawAacSettings := s.List()[0].(map[string]interface{})
+func expandAacCodecSettings(s []interface{}) *medialive.AacSettings {
+	if len(s) > 0 {
+		rawAacSettings := s[0].(map[string]interface{})
 		return &medialive.AacSettings{
 			Bitrate:         aws.Float64(rawAacSettings["bitrate"].(float64)),
 			CodingMode:      aws.String(rawAacSettings["coding_mode"].(string)),
@@ -160,10 +598,8 @@ func expandAacCodecSettings(s *schema.Set) *medialive.AacSettings {
 			SampleRate:      aws.Float64(rawAacSettings["sample_rate"].(float64)),
 			Spec:            aws.String(rawAacSettings["spec"].(string)),
 		}
-	} else {
-		log.Printf("[ERROR] MediaLive Channel: AAC Specification can not be found")
-		return &medialive.AacSettings{}
 	}
+	return nil
 }
 
 func expandOutputGroups(outputGroups []interface{}) []*medialive.OutputGroup {
@@ -178,38 +614,221 @@ func expandOutputGroups(outputGroups []interface{}) []*medialive.OutputGroup {
 
 		result = append(result, &medialive.OutputGroup{
 			Name:                aws.String(r["name"].(string)),
-			OutputGroupSettings: expandOutputGroupSettings(r["output_group_settings"].(*schema.Set)),
+			OutputGroupSettings: expandOutputGroupSettings(r["output_group_settings"].([]interface{})),
 			Outputs:             expandOutputs(r["outputs"].([]interface{})),
 		})
 	}
 	return result
 }
 
-func expandOutputGroupSettings(s *schema.Set) *medialive.OutputGroupSettings {
-	if s.Len() > 0 {
-		settings := s.List()[0].(map[string]interface{})
+// expandOutputGroupSettings expands whichever single group-settings variant
+// is present in configuration. resourceAwsMediaLiveChannelCustomizeDiff
+// enforces that exactly one of hls_group_settings, frame_capture_group_settings,
+// ms_smooth_group_settings, udp_group_settings, rtmp_group_settings,
+// archive_group_settings, media_package_group_settings,
+// cmaf_ingest_group_settings or multiplex_group_settings is ever set.
+func expandOutputGroupSettings(s []interface{}) *medialive.OutputGroupSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
 
-		// we can now have either hls, rmtp group, or both settings specified,
-		// to ensure a working api we need to ensure not to attach an empty
-		// hls or rtmp group struct
 		outputGroupSettings := medialive.OutputGroupSettings{}
-		hlsGroupSettings := expandHlsGroupSettings(settings["hls_group_settings"].(*schema.Set))
-		rtmpGroupSettings := expandRtmpGroupSettings(settings["rtmp_group_settings"].(*schema.Set))
 
-		// hls and rtmp group settings dont implement comparison operators
-		// so we are checking for hardcoded fields in our module to not be NIL
-		if hlsGroupSettings.Mode != nil {
-			outputGroupSettings.HlsGroupSettings = hlsGroupSettings
+		if v := settings["hls_group_settings"].([]interface{}); len(v) > 0 {
+			outputGroupSettings.HlsGroupSettings = expandHlsGroupSettings(v)
+		}
+		if v := settings["frame_capture_group_settings"].([]interface{}); len(v) > 0 {
+			outputGroupSettings.FrameCaptureGroupSettings = expandFrameCaptureGroupSettings(v)
+		}
+		if v := settings["ms_smooth_group_settings"].([]interface{}); len(v) > 0 {
+			outputGroupSettings.MsSmoothGroupSettings = expandMsSmoothGroupSettings(v)
+		}
+		if v := settings["udp_group_settings"].([]interface{}); len(v) > 0 {
+			outputGroupSettings.UdpGroupSettings = expandUdpGroupSettings(v)
+		}
+		if v := settings["rtmp_group_settings"].([]interface{}); len(v) > 0 {
+			outputGroupSettings.RtmpGroupSettings = expandRtmpGroupSettings(v)
 		}
-		if rtmpGroupSettings.AuthenticationScheme != nil {
-			outputGroupSettings.RtmpGroupSettings = rtmpGroupSettings
+		if v := settings["archive_group_settings"].([]interface{}); len(v) > 0 {
+			outputGroupSettings.ArchiveGroupSettings = expandArchiveGroupSettings(v)
+		}
+		if v := settings["media_package_group_settings"].([]interface{}); len(v) > 0 {
+			outputGroupSettings.MediaPackageGroupSettings = expandMediaPackageGroupSettings(v)
+		}
+		if v := settings["cmaf_ingest_group_settings"].([]interface{}); len(v) > 0 {
+			outputGroupSettings.CmafIngestGroupSettings = expandCmafIngestGroupSettings(v)
+		}
+		if v := settings["multiplex_group_settings"].([]interface{}); len(v) > 0 {
+			outputGroupSettings.MultiplexGroupSettings = &medialive.MultiplexGroupSettings{}
 		}
 
 		return &outputGroupSettings
-	} else {
-		log.Printf("[ERROR] MediaLive Channel: OutputGroupSettings can not be found")
-		return &medialive.OutputGroupSettings{}
 	}
+	return nil
+}
+
+func expandMsSmoothGroupSettings(s []interface{}) *medialive.MsSmoothGroupSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
+		result := &medialive.MsSmoothGroupSettings{
+			Destination: expandHlsDestinationRef(settings["destination"].([]interface{})),
+		}
+		if v := settings["acquisition_point_id"].(string); v != "" {
+			result.AcquisitionPointId = aws.String(v)
+		}
+		if v := settings["audio_only_timecode_control"].(string); v != "" {
+			result.AudioOnlyTimecodeControl = aws.String(v)
+		}
+		if v := settings["certificate_mode"].(string); v != "" {
+			result.CertificateMode = aws.String(v)
+		}
+		if v := settings["connection_retry_interval"].(int); v > 0 {
+			result.ConnectionRetryInterval = aws.Int64(int64(v))
+		}
+		if v := settings["event_id"].(string); v != "" {
+			result.EventId = aws.String(v)
+		}
+		if v := settings["event_id_mode"].(string); v != "" {
+			result.EventIdMode = aws.String(v)
+		}
+		if v := settings["event_stopped_behavior"].(string); v != "" {
+			result.EventStoppedBehavior = aws.String(v)
+		}
+		if v := settings["filecache_duration"].(int); v > 0 {
+			result.FilecacheDuration = aws.Int64(int64(v))
+		}
+		if v := settings["fragment_length"].(int); v > 0 {
+			result.FragmentLength = aws.Int64(int64(v))
+		}
+		if v := settings["input_loss_action"].(string); v != "" {
+			result.InputLossAction = aws.String(v)
+		}
+		if v := settings["num_retries"].(int); v > 0 {
+			result.NumRetries = aws.Int64(int64(v))
+		}
+		if v := settings["restart_delay"].(int); v > 0 {
+			result.RestartDelay = aws.Int64(int64(v))
+		}
+		if v := settings["segmentation_mode"].(string); v != "" {
+			result.SegmentationMode = aws.String(v)
+		}
+		if v := settings["send_delay_ms"].(int); v > 0 {
+			result.SendDelayMs = aws.Int64(int64(v))
+		}
+		if v := settings["sparse_track_type"].(string); v != "" {
+			result.SparseTrackType = aws.String(v)
+		}
+		if v := settings["stream_manifest_behavior"].(string); v != "" {
+			result.StreamManifestBehavior = aws.String(v)
+		}
+		if v := settings["timestamp_offset"].(string); v != "" {
+			result.TimestampOffset = aws.String(v)
+		}
+		if v := settings["timestamp_offset_mode"].(string); v != "" {
+			result.TimestampOffsetMode = aws.String(v)
+		}
+		return result
+	}
+	return nil
+}
+
+func expandUdpGroupSettings(s []interface{}) *medialive.UdpGroupSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
+		return &medialive.UdpGroupSettings{
+			InputLossAction:       aws.String(settings["input_loss_action"].(string)),
+			TimedMetadataId3Frame: aws.String(settings["timed_metadata_id3_frame"].(string)),
+			TimedMetadataId3Period: aws.Int64(
+				int64(settings["timed_metadata_id3_period"].(int)),
+			),
+		}
+	}
+	return nil
+}
+
+func expandArchiveGroupSettings(s []interface{}) *medialive.ArchiveGroupSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
+		result := &medialive.ArchiveGroupSettings{
+			Destination: expandHlsDestinationRef(settings["destination"].([]interface{})),
+		}
+		if v := settings["rollover_interval"].(int); v > 0 {
+			result.RolloverInterval = aws.Int64(int64(v))
+		}
+		if v := settings["archive_cdn_settings"].([]interface{}); len(v) > 0 {
+			cdnSettings := v[0].(map[string]interface{})
+			result.ArchiveCdnSettings = &medialive.ArchiveCdnSettings{}
+			if v := cdnSettings["archive_s3_settings"].([]interface{}); len(v) > 0 {
+				s3Settings := v[0].(map[string]interface{})
+				result.ArchiveCdnSettings.ArchiveS3Settings = &medialive.ArchiveS3Settings{}
+				if v := s3Settings["canned_acl"].(string); v != "" {
+					result.ArchiveCdnSettings.ArchiveS3Settings.CannedAcl = aws.String(v)
+				}
+			}
+		}
+		return result
+	}
+	return nil
+}
+
+func expandMediaPackageGroupSettings(s []interface{}) *medialive.MediaPackageGroupSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
+		return &medialive.MediaPackageGroupSettings{
+			Destination: expandHlsDestinationRef(settings["destination"].([]interface{})),
+		}
+	}
+	return nil
+}
+
+func expandCmafIngestGroupSettings(s []interface{}) *medialive.CmafIngestGroupSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
+		result := &medialive.CmafIngestGroupSettings{
+			Destination: expandHlsDestinationRef(settings["destination"].([]interface{})),
+		}
+		if v := settings["nielsen_id3_behavior"].(string); v != "" {
+			result.NielsenId3Behavior = aws.String(v)
+		}
+		if v := settings["scte35_type"].(string); v != "" {
+			result.Scte35Type = aws.String(v)
+		}
+		if v := settings["segment_length"].(int); v > 0 {
+			result.SegmentLength = aws.Int64(int64(v))
+		}
+		if v := settings["segment_length_units"].(string); v != "" {
+			result.SegmentLengthUnits = aws.String(v)
+		}
+		if v := settings["send_delay_ms"].(int); v > 0 {
+			result.SendDelayMs = aws.Int64(int64(v))
+		}
+		return result
+	}
+	return nil
+}
+
+func expandFrameCaptureGroupSettings(s []interface{}) *medialive.FrameCaptureGroupSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
+		result := &medialive.FrameCaptureGroupSettings{
+			Destination: expandHlsDestinationRef(settings["destination"].([]interface{})),
+		}
+		if v := settings["capture_interval"].(int); v > 0 {
+			result.CaptureInterval = aws.Int64(int64(v))
+		}
+		if v := settings["frame_capture_cdn_settings"].([]interface{}); len(v) > 0 {
+			cdnSettings := v[0].(map[string]interface{})
+			result.FrameCaptureCdnSettings = &medialive.FrameCaptureCdnSettings{}
+			if v := cdnSettings["frame_capture_s3_settings"].([]interface{}); len(v) > 0 {
+				s3Settings := v[0].(map[string]interface{})
+				result.FrameCaptureCdnSettings.FrameCaptureS3Settings = &medialive.FrameCaptureS3Settings{}
+				if v := s3Settings["canned_acl"].(string); v != "" {
+					result.FrameCaptureCdnSettings.FrameCaptureS3Settings.CannedAcl = aws.String(v)
+				}
+			}
+		}
+		return result
+	}
+	return nil
 }
 
 func expandOutputs(outputs []interface{}) []*medialive.Output {
@@ -232,171 +851,545 @@ func expandOutputs(outputs []interface{}) []*medialive.Output {
 			OutputName:              aws.String(r["output_name"].(string)),
 			AudioDescriptionNames:   expandStringList(r["audio_description_names"].([]interface{})),
 			CaptionDescriptionNames: expandStringList(r["caption_description_names"].([]interface{})),
-			OutputSettings:          expandOutputSettings(r["output_settings"].(*schema.Set)),
+			OutputSettings:          expandOutputSettings(r["output_settings"].([]interface{})),
 			VideoDescriptionName:    videoDescNameAws,
 		})
 	}
 	return result
 }
 
-func expandOutputSettings(s *schema.Set) *medialive.OutputSettings {
-	if s.Len() > 0 {
-		settings := s.List()[0].(map[string]interface{})
+// expandOutputSettings expands whichever single output-settings variant is
+// present in configuration, mirroring the *_group_settings variants of
+// expandOutputGroupSettings.
+func expandOutputSettings(s []interface{}) *medialive.OutputSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
 
-		// we can now have either hls or rmtp outputs, or both settings specified,
-		// to ensure a working api we need to ensure not to attach an empty
-		// hls or rtmp group struct
 		outputSettings := medialive.OutputSettings{}
-		hlsOutputSettings := expandHlsOutputSettings(settings["hls_output_settings"].(*schema.Set))
-		rtmpOutputSettings := expandRtmpOutputSettings(settings["rtmp_output_settings"].(*schema.Set))
 
-		if (*hlsOutputSettings != medialive.HlsOutputSettings{}) {
-			outputSettings.HlsOutputSettings = hlsOutputSettings
+		if v := settings["hls_output_settings"].([]interface{}); len(v) > 0 {
+			outputSettings.HlsOutputSettings = expandHlsOutputSettings(v)
+		}
+		if v := settings["frame_capture_output_settings"].([]interface{}); len(v) > 0 {
+			outputSettings.FrameCaptureOutputSettings = expandFrameCaptureOutputSettings(v)
+		}
+		if v := settings["ms_smooth_output_settings"].([]interface{}); len(v) > 0 {
+			outputSettings.MsSmoothOutputSettings = expandMsSmoothOutputSettings(v)
 		}
-		if (*rtmpOutputSettings != medialive.RtmpOutputSettings{}) {
-			outputSettings.RtmpOutputSettings = rtmpOutputSettings
+		if v := settings["udp_output_settings"].([]interface{}); len(v) > 0 {
+			outputSettings.UdpOutputSettings = expandUdpOutputSettings(v)
+		}
+		if v := settings["rtmp_output_settings"].([]interface{}); len(v) > 0 {
+			outputSettings.RtmpOutputSettings = expandRtmpOutputSettings(v)
+		}
+		if v := settings["archive_output_settings"].([]interface{}); len(v) > 0 {
+			outputSettings.ArchiveOutputSettings = expandArchiveOutputSettings(v)
+		}
+		if v := settings["media_package_output_settings"].([]interface{}); len(v) > 0 {
+			outputSettings.MediaPackageOutputSettings = &medialive.MediaPackageOutputSettings{}
+		}
+		if v := settings["cmaf_ingest_output_settings"].([]interface{}); len(v) > 0 {
+			outputSettings.CmafIngestOutputSettings = expandCmafIngestOutputSettings(v)
+		}
+		if v := settings["multiplex_output_settings"].([]interface{}); len(v) > 0 {
+			outputSettings.MultiplexOutputSettings = expandMultiplexOutputSettings(v)
 		}
 
 		return &outputSettings
-	} else {
-		log.Printf("[ERROR] MediaLive Channel: OutputSettings can not be found")
-		return &medialive.OutputSettings{}
 	}
+	return nil
 }
 
-func expandHlsOutputSettings(s *schema.Set) *medialive.HlsOutputSettings {
-	if s.Len() > 0 {
-		settings := s.List()[0].(map[string]interface{})
+func expandMultiplexOutputSettings(s []interface{}) *medialive.MultiplexOutputSettings {
+	settings := s[0].(map[string]interface{})
+	return &medialive.MultiplexOutputSettings{
+		Destination: expandMultiplexOutputDestination(settings["destination"].([]interface{})),
+	}
+}
+
+func expandMultiplexOutputDestination(s []interface{}) *medialive.OutputLocationRef {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
+		return &medialive.OutputLocationRef{
+			DestinationRefId: aws.String(settings["destination_ref_id"].(string)),
+		}
+	}
+	return nil
+}
+
+func expandHlsOutputSettings(s []interface{}) *medialive.HlsOutputSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
 		return &medialive.HlsOutputSettings{
-			HlsSettings:       expandHlsSettings(settings["hls_settings"].(*schema.Set)),
+			HlsSettings:       expandHlsSettings(settings["hls_settings"].([]interface{})),
 			NameModifier:      aws.String(settings["name_modifier"].(string)),
 			H265PackagingType: aws.String(settings["h_265_packaging_type"].(string)),
 			SegmentModifier:   aws.String(settings["segment_modifier"].(string)),
 		}
-	} else {
-		log.Printf("[ERROR] MediaLive Channel: HlsOutputSettings can not be found")
-		return &medialive.HlsOutputSettings{}
 	}
+	return nil
 }
 
-func expandHlsSettings(s *schema.Set) *medialive.HlsSettings {
-	if s.Len() > 0 {
-		settings := s.List()[0].(map[string]interface{})
+func expandHlsSettings(s []interface{}) *medialive.HlsSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
 		return &medialive.HlsSettings{
-			StandardHlsSettings:  expandStandardHlsSettings(settings["standard_hls_settings"].(*schema.Set)),
-			AudioOnlyHlsSettings: expandAudioOnlyHlsSettings(settings["audio_only_hls_settings"].(*schema.Set)),
+			StandardHlsSettings:  expandStandardHlsSettings(settings["standard_hls_settings"].([]interface{})),
+			AudioOnlyHlsSettings: expandAudioOnlyHlsSettings(settings["audio_only_hls_settings"].([]interface{})),
+		}
+	}
+	return nil
+}
+
+func expandStandardHlsSettings(s []interface{}) *medialive.StandardHlsSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
+		return &medialive.StandardHlsSettings{
+			AudioRenditionSets: aws.String(settings["audio_rendition_sets"].(string)),
+			M3u8Settings:       expandM3u8settings(settings["m3u8_settings"].([]interface{})),
+		}
+	} else {
+		return nil
+	}
+}
+
+// MARK: Caption Selectors
+
+func expandCaptionSelectors(captionSelectors []interface{}) []*medialive.CaptionSelector {
+	var result []*medialive.CaptionSelector
+
+	for _, descs := range captionSelectors {
+		r := descs.(map[string]interface{})
+
+		result = append(result, &medialive.CaptionSelector{
+			Name:             aws.String(r["name"].(string)),
+			SelectorSettings: expandCaptionSelectorSettings(r["selector_settings"].([]interface{})),
+		})
+	}
+	return result
+}
+
+func expandCaptionSelectorSettings(s []interface{}) *medialive.CaptionSelectorSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
+		result := &medialive.CaptionSelectorSettings{}
+		if v := settings["ancillary_source_settings"].([]interface{}); len(v) > 0 {
+			result.AncillarySourceSettings = expandAncillarySourceSettings(v)
+		}
+		if v := settings["arib_source_settings"].([]interface{}); len(v) > 0 {
+			result.AribSourceSettings = &medialive.AribSourceSettings{}
+		}
+		if v := settings["dvb_sub_source_settings"].([]interface{}); len(v) > 0 {
+			result.DvbSubSourceSettings = expandDvbSubSourceSettings(v)
+		}
+		if v := settings["embedded_source_settings"].([]interface{}); len(v) > 0 {
+			result.EmbeddedSourceSettings = expandEmbeddedSourceSettings(v)
+		}
+		if v := settings["scte20_source_settings"].([]interface{}); len(v) > 0 {
+			result.Scte20SourceSettings = expandScte20SourceSettings(v)
+		}
+		if v := settings["scte27_source_settings"].([]interface{}); len(v) > 0 {
+			result.Scte27SourceSettings = expandScte27SourceSettings(v)
+		}
+		if v := settings["teletext_source_settings"].([]interface{}); len(v) > 0 {
+			result.TeletextSourceSettings = expandTeletextSourceSettings(v)
+		}
+		return result
+	} else {
+		return nil
+	}
+}
+
+func expandAncillarySourceSettings(s []interface{}) *medialive.AncillarySourceSettings {
+	settings := s[0].(map[string]interface{})
+	return &medialive.AncillarySourceSettings{
+		SourceAncillaryChannelNumber: aws.Int64(int64(settings["source_ancillary_channel_number"].(int))),
+	}
+}
+
+func expandDvbSubSourceSettings(s []interface{}) *medialive.DvbSubSourceSettings {
+	settings := s[0].(map[string]interface{})
+	result := &medialive.DvbSubSourceSettings{}
+	if v := settings["ocr_language"].(string); v != "" {
+		result.OcrLanguage = aws.String(v)
+	}
+	if v := settings["pid"].(int); v > 0 {
+		result.Pid = aws.Int64(int64(v))
+	}
+	return result
+}
+
+func expandScte20SourceSettings(s []interface{}) *medialive.Scte20SourceSettings {
+	settings := s[0].(map[string]interface{})
+	result := &medialive.Scte20SourceSettings{}
+	if v := settings["convert608_to708"].(string); v != "" {
+		result.Convert608To708 = aws.String(v)
+	}
+	if v := settings["source608_channel_number"].(int); v > 0 {
+		result.Source608ChannelNumber = aws.Int64(int64(v))
+	}
+	return result
+}
+
+func expandScte27SourceSettings(s []interface{}) *medialive.Scte27SourceSettings {
+	settings := s[0].(map[string]interface{})
+	result := &medialive.Scte27SourceSettings{}
+	if v := settings["ocr_language"].(string); v != "" {
+		result.OcrLanguage = aws.String(v)
+	}
+	if v := settings["pid"].(int); v > 0 {
+		result.Pid = aws.Int64(int64(v))
+	}
+	return result
+}
+
+func expandTeletextSourceSettings(s []interface{}) *medialive.TeletextSourceSettings {
+	settings := s[0].(map[string]interface{})
+	result := &medialive.TeletextSourceSettings{}
+	if v := settings["page_number"].(string); v != "" {
+		result.PageNumber = aws.String(v)
+	}
+	return result
+}
+
+func expandEmbeddedSourceSettings(s []interface{}) *medialive.EmbeddedSourceSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
+		return &medialive.EmbeddedSourceSettings{
+			Convert608To708:        aws.String(settings["convert608_to708"].(string)),
+			Scte20Detection:        aws.String(settings["scte20_detection"].(string)),
+			Source608ChannelNumber: aws.Int64(int64(settings["source608_channel_number"].(int))),
+			Source608TrackNumber:   aws.Int64(int64(settings["source608_track_number"].(int))),
+		}
+	}
+	return nil
+}
+
+// MARK: Audio Selectors
+
+func expandAudioSelectors(audioSelectors []interface{}) []*medialive.AudioSelector {
+	var result []*medialive.AudioSelector
+
+	for _, descs := range audioSelectors {
+		r := descs.(map[string]interface{})
+
+		result = append(result, &medialive.AudioSelector{
+			Name:             aws.String(r["name"].(string)),
+			SelectorSettings: expandAudioSelectorSettings(r["selector_settings"].([]interface{})),
+		})
+	}
+	return result
+}
+
+func expandAudioSelectorSettings(s []interface{}) *medialive.AudioSelectorSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
+		result := &medialive.AudioSelectorSettings{}
+		if v := settings["audio_language_selection"].([]interface{}); len(v) > 0 {
+			result.AudioLanguageSelection = expandAudioLanguageSelection(v)
+		}
+		if v := settings["audio_pid_selection"].([]interface{}); len(v) > 0 {
+			result.AudioPidSelection = expandAudioPidSelection(v)
+		}
+		if v := settings["audio_track_selection"].([]interface{}); len(v) > 0 {
+			result.AudioTrackSelection = expandAudioTrackSelection(v)
+		}
+		return result
+	} else {
+		return nil
+	}
+}
+
+func expandAudioLanguageSelection(s []interface{}) *medialive.AudioLanguageSelection {
+	settings := s[0].(map[string]interface{})
+	result := &medialive.AudioLanguageSelection{
+		LanguageCode: aws.String(settings["language_code"].(string)),
+	}
+	if v := settings["language_selection_policy"].(string); v != "" {
+		result.LanguageSelectionPolicy = aws.String(v)
+	}
+	return result
+}
+
+func expandAudioPidSelection(s []interface{}) *medialive.AudioPidSelection {
+	settings := s[0].(map[string]interface{})
+	return &medialive.AudioPidSelection{
+		Pid: aws.Int64(int64(settings["pid"].(int))),
+	}
+}
+
+func expandAudioTrackSelection(s []interface{}) *medialive.AudioTrackSelection {
+	settings := s[0].(map[string]interface{})
+	return &medialive.AudioTrackSelection{
+		Tracks: expandAudioTracks(settings["tracks"].([]interface{})),
+	}
+}
+
+func expandAudioTracks(tracks []interface{}) []*medialive.AudioTrack {
+	var result []*medialive.AudioTrack
+	for _, rawTrack := range tracks {
+		t := rawTrack.(map[string]interface{})
+		result = append(result, &medialive.AudioTrack{
+			Track: aws.Int64(int64(t["track"].(int))),
+		})
+	}
+	return result
+}
+
+// MARK: Caption Descriptions
+
+func expandCaptionDescriptions(captionDescriptions []interface{}) []*medialive.CaptionDescription {
+	var result []*medialive.CaptionDescription
+
+	for _, descs := range captionDescriptions {
+		r := descs.(map[string]interface{})
+
+		result = append(result, &medialive.CaptionDescription{
+			CaptionSelectorName: aws.String(r["caption_selector_name"].(string)),
+			Name:                aws.String(r["name"].(string)),
+			DestinationSettings: expandCaptionDestinationSettings(r["destination_settings"].([]interface{})),
+		})
+	}
+	return result
+}
+
+func expandCaptionDestinationSettings(s []interface{}) *medialive.CaptionDestinationSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
+		result := &medialive.CaptionDestinationSettings{}
+		if v := settings["arib_destination_settings"].([]interface{}); len(v) > 0 {
+			result.AribDestinationSettings = &medialive.AribDestinationSettings{}
+		}
+		if v := settings["burn_in_destination_settings"].([]interface{}); len(v) > 0 {
+			result.BurnInDestinationSettings = expandBurnInDestinationSettings(v)
+		}
+		if v := settings["dvb_sub_destination_settings"].([]interface{}); len(v) > 0 {
+			result.DvbSubDestinationSettings = expandDvbSubDestinationSettings(v)
+		}
+		if v := settings["ebu_tt_d_destination_settings"].([]interface{}); len(v) > 0 {
+			result.EbuTtDDestinationSettings = expandEbuTtDDestinationSettings(v)
+		}
+		if v := settings["embedded_destination_settings"].([]interface{}); len(v) > 0 {
+			result.EmbeddedDestinationSettings = expandEmbeddedDestinationSettings(v)
+		}
+		if v := settings["embedded_plus_scte20_destination_settings"].([]interface{}); len(v) > 0 {
+			result.EmbeddedPlusScte20DestinationSettings = &medialive.EmbeddedPlusScte20DestinationSettings{}
+		}
+		if v := settings["rtmp_caption_info_destination_settings"].([]interface{}); len(v) > 0 {
+			result.RtmpCaptionInfoDestinationSettings = &medialive.RtmpCaptionInfoDestinationSettings{}
+		}
+		if v := settings["scte20_plus_embedded_destination_settings"].([]interface{}); len(v) > 0 {
+			result.Scte20PlusEmbeddedDestinationSettings = &medialive.Scte20PlusEmbeddedDestinationSettings{}
+		}
+		if v := settings["scte27_destination_settings"].([]interface{}); len(v) > 0 {
+			result.Scte27DestinationSettings = &medialive.Scte27DestinationSettings{}
+		}
+		if v := settings["smpte_tt_destination_settings"].([]interface{}); len(v) > 0 {
+			result.SmpteTtDestinationSettings = &medialive.SmpteTtDestinationSettings{}
+		}
+		if v := settings["teletext_destination_settings"].([]interface{}); len(v) > 0 {
+			result.TeletextDestinationSettings = &medialive.TeletextDestinationSettings{}
+		}
+		if v := settings["ttml_destination_settings"].([]interface{}); len(v) > 0 {
+			result.TtmlDestinationSettings = expandTtmlDestinationSettings(v)
+		}
+		if v := settings["webvtt_destination_settings"].([]interface{}); len(v) > 0 {
+			result.WebvttDestinationSettings = expandWebvttDestinationSettings(v)
+		}
+		return result
+	} else {
+		return nil
+	}
+}
+
+func expandBurnInDestinationSettings(s []interface{}) *medialive.BurnInDestinationSettings {
+	settings := s[0].(map[string]interface{})
+	result := &medialive.BurnInDestinationSettings{}
+	applyCaptionStyleSettings(result, settings)
+	return result
+}
+
+func expandDvbSubDestinationSettings(s []interface{}) *medialive.DvbSubDestinationSettings {
+	settings := s[0].(map[string]interface{})
+	result := &medialive.DvbSubDestinationSettings{}
+	applyCaptionStyleSettings(result, settings)
+	return result
+}
+
+// applyCaptionStyleSettings copies the styling fields shared by
+// BurnInDestinationSettings and DvbSubDestinationSettings out of the raw
+// config map. The two generated SDK types don't share an interface for
+// these setters, so each field is set directly rather than through one.
+func applyCaptionStyleSettings(result interface{}, settings map[string]interface{}) {
+	switch r := result.(type) {
+	case *medialive.BurnInDestinationSettings:
+		if v := settings["alignment"].(string); v != "" {
+			r.Alignment = aws.String(v)
+		}
+		if v := settings["background_color"].(string); v != "" {
+			r.BackgroundColor = aws.String(v)
+		}
+		if v := settings["background_opacity"].(int); v > 0 {
+			r.BackgroundOpacity = aws.Int64(int64(v))
+		}
+		if v := settings["font"].([]interface{}); len(v) > 0 {
+			font := v[0].(map[string]interface{})
+			r.Font = &medialive.InputLocation{
+				Uri:      aws.String(font["uri"].(string)),
+				Username: aws.String(font["username"].(string)),
+			}
+			if v := font["password_param"].(string); v != "" {
+				r.Font.PasswordParam = aws.String(v)
+			}
+		}
+		if v := settings["font_color"].(string); v != "" {
+			r.FontColor = aws.String(v)
+		}
+		if v := settings["font_opacity"].(int); v > 0 {
+			r.FontOpacity = aws.Int64(int64(v))
+		}
+		if v := settings["font_resolution"].(int); v > 0 {
+			r.FontResolution = aws.Int64(int64(v))
+		}
+		if v := settings["font_size"].(string); v != "" {
+			r.FontSize = aws.String(v)
+		}
+		if v := settings["outline_color"].(string); v != "" {
+			r.OutlineColor = aws.String(v)
+		}
+		if v := settings["outline_size"].(int); v > 0 {
+			r.OutlineSize = aws.Int64(int64(v))
+		}
+		if v := settings["shadow_color"].(string); v != "" {
+			r.ShadowColor = aws.String(v)
+		}
+		if v := settings["shadow_opacity"].(int); v > 0 {
+			r.ShadowOpacity = aws.Int64(int64(v))
+		}
+		if v := settings["shadow_x_offset"].(int); v != 0 {
+			r.ShadowXOffset = aws.Int64(int64(v))
+		}
+		if v := settings["shadow_y_offset"].(int); v != 0 {
+			r.ShadowYOffset = aws.Int64(int64(v))
+		}
+		if v := settings["teletext_grid_control"].(string); v != "" {
+			r.TeletextGridControl = aws.String(v)
+		}
+		if v := settings["x_position"].(int); v > 0 {
+			r.XPosition = aws.Int64(int64(v))
+		}
+		if v := settings["y_position"].(int); v > 0 {
+			r.YPosition = aws.Int64(int64(v))
+		}
+	case *medialive.DvbSubDestinationSettings:
+		if v := settings["alignment"].(string); v != "" {
+			r.Alignment = aws.String(v)
+		}
+		if v := settings["background_color"].(string); v != "" {
+			r.BackgroundColor = aws.String(v)
+		}
+		if v := settings["background_opacity"].(int); v > 0 {
+			r.BackgroundOpacity = aws.Int64(int64(v))
+		}
+		if v := settings["font"].([]interface{}); len(v) > 0 {
+			font := v[0].(map[string]interface{})
+			r.Font = &medialive.InputLocation{
+				Uri:      aws.String(font["uri"].(string)),
+				Username: aws.String(font["username"].(string)),
+			}
+			if v := font["password_param"].(string); v != "" {
+				r.Font.PasswordParam = aws.String(v)
+			}
+		}
+		if v := settings["font_color"].(string); v != "" {
+			r.FontColor = aws.String(v)
+		}
+		if v := settings["font_opacity"].(int); v > 0 {
+			r.FontOpacity = aws.Int64(int64(v))
+		}
+		if v := settings["font_resolution"].(int); v > 0 {
+			r.FontResolution = aws.Int64(int64(v))
+		}
+		if v := settings["font_size"].(string); v != "" {
+			r.FontSize = aws.String(v)
+		}
+		if v := settings["outline_color"].(string); v != "" {
+			r.OutlineColor = aws.String(v)
+		}
+		if v := settings["outline_size"].(int); v > 0 {
+			r.OutlineSize = aws.Int64(int64(v))
+		}
+		if v := settings["shadow_color"].(string); v != "" {
+			r.ShadowColor = aws.String(v)
+		}
+		if v := settings["shadow_opacity"].(int); v > 0 {
+			r.ShadowOpacity = aws.Int64(int64(v))
+		}
+		if v := settings["shadow_x_offset"].(int); v != 0 {
+			r.ShadowXOffset = aws.Int64(int64(v))
+		}
+		if v := settings["shadow_y_offset"].(int); v != 0 {
+			r.ShadowYOffset = aws.Int64(int64(v))
+		}
+		if v := settings["teletext_grid_control"].(string); v != "" {
+			r.TeletextGridControl = aws.String(v)
 		}
-	} else {
-		log.Printf("[ERROR] MediaLive Channel: HlsSettings can not be found")
-		return &medialive.HlsSettings{}
-	}
-}
-
-func expandStandardHlsSettings(s *schema.Set) *medialive.StandardHlsSettings {
-	if s.Len() > 0 {
-		settings := s.List()[0].(map[string]interface{})
-		return &medialive.StandardHlsSettings{
-			AudioRenditionSets: aws.String(settings["audio_rendition_sets"].(string)),
-			M3u8Settings:       expandM3u8settings(settings["m3u8_settings"].(*schema.Set)),
+		if v := settings["x_position"].(int); v > 0 {
+			r.XPosition = aws.Int64(int64(v))
+		}
+		if v := settings["y_position"].(int); v > 0 {
+			r.YPosition = aws.Int64(int64(v))
 		}
-	} else {
-		return nil
 	}
 }
 
-// MARK: Caption Selectors
-
-func expandCaptionSelectors(captionSelectors []interface{}) []*medialive.CaptionSelector {
-	var result []*medialive.CaptionSelector
-
-	for _, descs := range captionSelectors {
-		r := descs.(map[string]interface{})
-
-		result = append(result, &medialive.CaptionSelector{
-			Name:             aws.String(r["name"].(string)),
-			SelectorSettings: expandCaptionSelectorSettings(r["selector_settings"].(*schema.Set)),
-		})
+func expandEbuTtDDestinationSettings(s []interface{}) *medialive.EbuTtDDestinationSettings {
+	settings := s[0].(map[string]interface{})
+	result := &medialive.EbuTtDDestinationSettings{}
+	if v := settings["copyright_holder"].(string); v != "" {
+		result.CopyrightHolder = aws.String(v)
 	}
-	return result
-}
-
-func expandCaptionSelectorSettings(s *schema.Set) *medialive.CaptionSelectorSettings {
-	if s.Len() > 0 {
-		settings := s.List()[0].(map[string]interface{})
-		return &medialive.CaptionSelectorSettings{
-			EmbeddedSourceSettings: expandEmbeddedSourceSettings(settings["embedded_source_settings"].(*schema.Set)),
-		}
-	} else {
-		return nil
+	if v := settings["fill_line_gap"].(string); v != "" {
+		result.FillLineGap = aws.String(v)
 	}
-}
-
-func expandEmbeddedSourceSettings(s *schema.Set) *medialive.EmbeddedSourceSettings {
-	if s.Len() > 0 {
-		settings := s.List()[0].(map[string]interface{})
-		return &medialive.EmbeddedSourceSettings{
-			Convert608To708:        aws.String(settings["convert608_to708"].(string)),
-			Scte20Detection:        aws.String(settings["scte20_detection"].(string)),
-			Source608ChannelNumber: aws.Int64(int64(settings["source608_channel_number"].(int))),
-			Source608TrackNumber:   aws.Int64(int64(settings["source608_track_number"].(int))),
-		}
-	} else {
-		log.Printf("[ERROR] MediaLive Channel: EmbeddedSourceSettings can not be found")
-		return &medialive.EmbeddedSourceSettings{}
+	if v := settings["font_family"].(string); v != "" {
+		result.FontFamily = aws.String(v)
 	}
-}
-
-// MARK: Caption Descriptions
-
-func expandCaptionDescriptions(captionDescriptions []interface{}) []*medialive.CaptionDescription {
-	var result []*medialive.CaptionDescription
-
-	for _, descs := range captionDescriptions {
-		r := descs.(map[string]interface{})
-
-		result = append(result, &medialive.CaptionDescription{
-			CaptionSelectorName: aws.String(r["caption_selector_name"].(string)),
-			Name:                aws.String(r["name"].(string)),
-			DestinationSettings: expandCaptionDestinationSettings(r["destination_settings"].(*schema.Set)),
-		})
+	if v := settings["style_control"].(string); v != "" {
+		result.StyleControl = aws.String(v)
 	}
 	return result
 }
 
-func expandCaptionDestinationSettings(s *schema.Set) *medialive.CaptionDestinationSettings {
-	if s.Len() > 0 {
-		settings := s.List()[0].(map[string]interface{})
-		return &medialive.CaptionDestinationSettings{
-			WebvttDestinationSettings:   expandWebvttDestinationSettings(settings["webvtt_destination_settings"].(*schema.Set)),
-			EmbeddedDestinationSettings: expandEmbeddedDestinationSettings(settings["embedded_destination_settings"].(*schema.Set)),
-		}
-	} else {
-		return nil
+func expandTtmlDestinationSettings(s []interface{}) *medialive.TtmlDestinationSettings {
+	settings := s[0].(map[string]interface{})
+	result := &medialive.TtmlDestinationSettings{}
+	if v := settings["style_control"].(string); v != "" {
+		result.StyleControl = aws.String(v)
 	}
+	return result
 }
 
-func expandWebvttDestinationSettings(s *schema.Set) *medialive.WebvttDestinationSettings {
-	if s.Len() > 0 {
-		settings := s.List()[0].(map[string]interface{})
-		return &medialive.WebvttDestinationSettings{
-			StyleControl: aws.String(settings["style_control"].(string)),
-		}
-	} else {
-		return nil
+func expandWebvttDestinationSettings(s []interface{}) *medialive.WebvttDestinationSettings {
+	settings := s[0].(map[string]interface{})
+	result := &medialive.WebvttDestinationSettings{}
+	if v := settings["style_control"].(string); v != "" {
+		result.StyleControl = aws.String(v)
 	}
+	return result
 }
 
-func expandEmbeddedDestinationSettings(s *schema.Set) *medialive.EmbeddedDestinationSettings {
-	if s.Len() > 0 {
+func expandEmbeddedDestinationSettings(s []interface{}) *medialive.EmbeddedDestinationSettings {
+	if len(s) > 0 {
 		return &medialive.EmbeddedDestinationSettings{}
 	} else {
 		return nil
 	}
 }
 
-func expandAudioOnlyHlsSettings(s *schema.Set) *medialive.AudioOnlyHlsSettings {
-	if s.Len() > 0 {
-		settings := s.List()[0].(map[string]interface{})
+func expandAudioOnlyHlsSettings(s []interface{}) *medialive.AudioOnlyHlsSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
 		return &medialive.AudioOnlyHlsSettings{
 			AudioGroupId:   aws.String(settings["audio_group_id"].(string)),
 			AudioTrackType: aws.String(settings["audio_track_type"].(string)),
@@ -407,12 +1400,15 @@ func expandAudioOnlyHlsSettings(s *schema.Set) *medialive.AudioOnlyHlsSettings {
 	}
 }
 
-func expandM3u8settings(s *schema.Set) *medialive.M3u8Settings {
-	if s.Len() > 0 {
-		settings := s.List()[0].(map[string]interface{})
+func expandM3u8settings(s []interface{}) *medialive.M3u8Settings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
 		return &medialive.M3u8Settings{
 			AudioFramesPerPes:     aws.Int64(int64(settings["audio_frames_per_pes"].(int))),
 			AudioPids:             aws.String(settings["audio_pids"].(string)),
+			EcmPid:                aws.String(settings["ecm_pid"].(string)),
+			KlvBehavior:           aws.String(settings["klv_behavior"].(string)),
+			KlvDataPids:           aws.String(settings["klv_data_pids"].(string)),
 			NielsenId3Behavior:    aws.String(settings["nielsen_id3_behavior"].(string)),
 			PatInterval:           aws.Int64(int64(settings["pat_interval"].(int))),
 			PcrControl:            aws.String(settings["pcr_control"].(string)),
@@ -424,21 +1420,19 @@ func expandM3u8settings(s *schema.Set) *medialive.M3u8Settings {
 			TimedMetadataPid:      aws.String(settings["timed_metadata_pid"].(string)),
 			VideoPid:              aws.String(settings["video_pid"].(string)),
 		}
-	} else {
-		log.Printf("[ERROR] MediaLive Channel: M3u8Settings can not be found")
-		return &medialive.M3u8Settings{}
 	}
+	return nil
 }
 
-func expandHlsGroupSettings(s *schema.Set) *medialive.HlsGroupSettings {
-	if s.Len() > 0 {
-		settings := s.List()[0].(map[string]interface{})
-		return &medialive.HlsGroupSettings{
+func expandHlsGroupSettings(s []interface{}) *medialive.HlsGroupSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
+		result := &medialive.HlsGroupSettings{
 			CaptionLanguageSetting:     aws.String(settings["caption_language_setting"].(string)),
 			CaptionLanguageMappings:    expandCaptionLanguageMapping(settings["caption_language_mapping"].([]interface{})),
 			CodecSpecification:         aws.String(settings["codec_specification"].(string)),
 			ClientCache:                aws.String(settings["client_cache"].(string)),
-			HlsCdnSettings:             expandHlsCdnSettings(settings["hls_cdn_settings"].(*schema.Set)),
+			HlsCdnSettings:             expandHlsCdnSettings(settings["hls_cdn_settings"].([]interface{})),
 			HlsId3SegmentTagging:       aws.String(settings["hls_id3_segment_tagging"].(string)),
 			IndexNSegments:             aws.Int64(int64(settings["index_n_segments"].(int))),
 			InputLossAction:            aws.String(settings["input_loss_action"].(string)),
@@ -455,7 +1449,7 @@ func expandHlsGroupSettings(s *schema.Set) *medialive.HlsGroupSettings {
 			RedundantManifest:          aws.String(settings["redundant_manifest"].(string)),
 			SegmentationMode:           aws.String(settings["segmentation_mode"].(string)),
 			SegmentLength:              aws.Int64(int64(settings["segment_length"].(int))),
-			Destination:                expandHlsDestinationRef(settings["destination"].(*schema.Set)),
+			Destination:                expandHlsDestinationRef(settings["destination"].([]interface{})),
 			DirectoryStructure:         aws.String(settings["directory_structure"].(string)),
 			SegmentsPerSubdirectory:    aws.Int64(int64(settings["segments_per_subdirectory"].(int))),
 			StreamInfResolution:        aws.String(settings["stream_inf_resolution"].(string)),
@@ -464,10 +1458,70 @@ func expandHlsGroupSettings(s *schema.Set) *medialive.HlsGroupSettings {
 			TimestampDeltaMilliseconds: aws.Int64(int64(settings["timestamp_delta_milliseconds"].(int))),
 			TsFileMode:                 aws.String(settings["ts_file_mode"].(string)),
 		}
-	} else {
-		log.Printf("[ERROR] MediaLive Channel: HlsGroupSettings can not be found")
-		return &medialive.HlsGroupSettings{}
+		if v := settings["base_url_content"].(string); v != "" {
+			result.BaseUrlContent = aws.String(v)
+		}
+		if v := settings["base_url_manifest"].(string); v != "" {
+			result.BaseUrlManifest = aws.String(v)
+		}
+		if v := settings["constant_iv"].(string); v != "" {
+			result.ConstantIv = aws.String(v)
+		}
+		if v := settings["ad_markers"].([]interface{}); len(v) > 0 {
+			result.AdMarkers = expandStringList(v)
+		}
+		if v := settings["encryption_type"].(string); v != "" {
+			result.EncryptionType = aws.String(v)
+		}
+		if v := settings["program_date_time_clock"].(string); v != "" {
+			result.ProgramDateTimeClock = aws.String(v)
+		}
+		if v := settings["discontinuity_tags"].(string); v != "" {
+			result.DiscontinuityTags = aws.String(v)
+		}
+		if v := settings["incomplete_segment_behavior"].(string); v != "" {
+			result.IncompleteSegmentBehavior = aws.String(v)
+		}
+		if v := settings["min_segment_length"].(int); v > 0 {
+			result.MinSegmentLength = aws.Int64(int64(v))
+		}
+		if v := settings["key_provider_settings"].([]interface{}); len(v) > 0 {
+			result.KeyProviderSettings = expandKeyProviderSettings(v)
+		}
+		return result
+	}
+	return nil
+}
+
+func expandKeyProviderSettings(s []interface{}) *medialive.KeyProviderSettings {
+	settings := s[0].(map[string]interface{})
+	result := &medialive.KeyProviderSettings{}
+	if v := settings["static_key_settings"].([]interface{}); len(v) > 0 {
+		result.StaticKeySettings = expandStaticKeySettings(v)
+	}
+	return result
+}
+
+func expandStaticKeySettings(s []interface{}) *medialive.StaticKeySettings {
+	settings := s[0].(map[string]interface{})
+	return &medialive.StaticKeySettings{
+		StaticKeyValue:    aws.String(settings["static_key_value"].(string)),
+		KeyProviderServer: expandKeyProviderServer(settings["key_provider_server"].([]interface{})),
+	}
+}
+
+func expandKeyProviderServer(s []interface{}) *medialive.InputLocation {
+	settings := s[0].(map[string]interface{})
+	result := &medialive.InputLocation{
+		Uri: aws.String(settings["uri"].(string)),
+	}
+	if v := settings["password_param"].(string); v != "" {
+		result.PasswordParam = aws.String(v)
 	}
+	if v := settings["username"].(string); v != "" {
+		result.Username = aws.String(v)
+	}
+	return result
 }
 
 func expandCaptionLanguageMapping(captionMappings []interface{}) []*medialive.CaptionLanguageMapping {
@@ -487,51 +1541,89 @@ func expandCaptionLanguageMapping(captionMappings []interface{}) []*medialive.Ca
 	return result
 }
 
-func expandHlsCdnSettings(s *schema.Set) *medialive.HlsCdnSettings {
-	if s.Len() > 0 {
-		settings := s.List()[0].(map[string]interface{})
-		return &medialive.HlsCdnSettings{
-			HlsBasicPutSettings: expandHlsBasicPutSettings(settings["hls_basic_put_settings"].(*schema.Set)),
-			//TODO: ADD support for Akamai CDN and MediaStore origin
-			//HlsMediaStoreSettings: expandHlsMediaStoreSettings(settings["h264_settings"].(*schema.Set)),
-			//HlsAkamaiSettings: expandHlsAkamaiSettings(settings["h264_settings"].(*schema.Set)),
+// expandHlsCdnSettings expands the single CDN backend configured under
+// hls_cdn_settings. Only one of hls_basic_put_settings, hls_akamai_settings
+// or hls_media_store_settings is expected to be set at a time -- see
+// resourceAwsMediaLiveChannelCustomizeDiff, which enforces that -- so only
+// the backend that is actually present in configuration is expanded.
+// hls_akamai_settings and hls_media_store_settings are both fully wired
+// below alongside hls_basic_put_settings.
+func expandHlsCdnSettings(s []interface{}) *medialive.HlsCdnSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
+		result := &medialive.HlsCdnSettings{}
+
+		if v := settings["hls_basic_put_settings"].([]interface{}); len(v) > 0 {
+			result.HlsBasicPutSettings = expandHlsBasicPutSettings(v)
 		}
-	} else {
-		log.Printf("[WARN] MediaLive Channel: HlsCdnSettings can not be found")
-		return &medialive.HlsCdnSettings{}
+		if v := settings["hls_akamai_settings"].([]interface{}); len(v) > 0 {
+			result.HlsAkamaiSettings = expandHlsAkamaiSettings(v)
+		}
+		if v := settings["hls_media_store_settings"].([]interface{}); len(v) > 0 {
+			result.HlsMediaStoreSettings = expandHlsMediaStoreSettings(v)
+		}
+
+		return result
 	}
+	return nil
 }
 
-func expandHlsDestinationRef(s *schema.Set) *medialive.OutputLocationRef {
-	if s.Len() > 0 {
-		settings := s.List()[0].(map[string]interface{})
+func expandHlsDestinationRef(s []interface{}) *medialive.OutputLocationRef {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
 		return &medialive.OutputLocationRef{
 			DestinationRefId: aws.String(settings["destination_ref_id"].(string)),
 		}
-	} else {
-		log.Printf("[WARN] MediaLive Channel: HLS Destination (OutputLocationRef) can not be found")
-		return &medialive.OutputLocationRef{}
 	}
+	return nil
 }
 
-func expandHlsBasicPutSettings(s *schema.Set) *medialive.HlsBasicPutSettings {
-	if s.Len() > 0 {
-		settings := s.List()[0].(map[string]interface{})
+func expandHlsBasicPutSettings(s []interface{}) *medialive.HlsBasicPutSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
 		return &medialive.HlsBasicPutSettings{
 			ConnectionRetryInterval: aws.Int64(int64(settings["connection_retry_interval"].(int))),
 			FilecacheDuration:       aws.Int64(int64(settings["filecache_duration"].(int))),
 			NumRetries:              aws.Int64(int64(settings["num_retries"].(int))),
 			RestartDelay:            aws.Int64(int64(settings["restart_delay"].(int))),
 		}
-	} else {
-		log.Printf("[WARN] MediaLive Channel: HlsBasicPutSettings can not be found")
-		return &medialive.HlsBasicPutSettings{}
 	}
+	return nil
+}
+
+func expandHlsAkamaiSettings(s []interface{}) *medialive.HlsAkamaiSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
+		return &medialive.HlsAkamaiSettings{
+			ConnectionRetryInterval: aws.Int64(int64(settings["connection_retry_interval"].(int))),
+			FilecacheDuration:       aws.Int64(int64(settings["filecache_duration"].(int))),
+			HttpTransferMode:        aws.String(settings["http_transfer_mode"].(string)),
+			NumRetries:              aws.Int64(int64(settings["num_retries"].(int))),
+			RestartDelay:            aws.Int64(int64(settings["restart_delay"].(int))),
+			Salt:                    aws.String(settings["salt"].(string)),
+			Token:                   aws.String(settings["token"].(string)),
+		}
+	}
+	return nil
 }
 
-func expandInputAttachmentSettings(s *schema.Set) *medialive.InputSettings {
-	if s.Len() > 0 {
-		rawInputSettings := s.List()[0].(map[string]interface{})
+func expandHlsMediaStoreSettings(s []interface{}) *medialive.HlsMediaStoreSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
+		return &medialive.HlsMediaStoreSettings{
+			ConnectionRetryInterval: aws.Int64(int64(settings["connection_retry_interval"].(int))),
+			FilecacheDuration:       aws.Int64(int64(settings["filecache_duration"].(int))),
+			MediaStoreStorageClass:  aws.String(settings["media_store_storage_class"].(string)),
+			NumRetries:              aws.Int64(int64(settings["num_retries"].(int))),
+			RestartDelay:            aws.Int64(int64(settings["restart_delay"].(int))),
+		}
+	}
+	return nil
+}
+
+func expandInputAttachmentSettings(s []interface{}) *medialive.InputSettings {
+	if len(s) > 0 {
+		rawInputSettings := s[0].(map[string]interface{})
 		return &medialive.InputSettings{
 			DeblockFilter:           aws.String(rawInputSettings["deblock_filter"].(string)),
 			DenoiseFilter:           aws.String(rawInputSettings["denoise_filter"].(string)),
@@ -540,24 +1632,21 @@ func expandInputAttachmentSettings(s *schema.Set) *medialive.InputSettings {
 			SourceEndBehavior:       aws.String(rawInputSettings["source_end_behavior"].(string)),
 			Smpte2038DataPreference: aws.String(rawInputSettings["smpte2038_data_preference"].(string)),
 			CaptionSelectors:        expandCaptionSelectors(rawInputSettings["caption_selectors"].([]interface{})),
+			AudioSelectors:          expandAudioSelectors(rawInputSettings["audio_selectors"].([]interface{})),
 		}
-	} else {
-		log.Printf("[ERROR] MediaLive Channel: InputSettings can not be found")
-		return &medialive.InputSettings{}
 	}
+	return nil
 }
 
-func expandTimecodeConfigs(s *schema.Set) *medialive.TimecodeConfig {
-	if s.Len() > 0 {
-		rawTimecodeConfig := s.List()[0].(map[string]interface{})
+func expandTimecodeConfigs(s []interface{}) *medialive.TimecodeConfig {
+	if len(s) > 0 {
+		rawTimecodeConfig := s[0].(map[string]interface{})
 		return &medialive.TimecodeConfig{
 			Source:        aws.String(rawTimecodeConfig["source"].(string)),
 			SyncThreshold: aws.Int64(int64(rawTimecodeConfig["sync_threshold"].(int))),
 		}
-	} else {
-		log.Printf("[ERROR] MediaLive Channel: TimecodeConfig can not be found")
-		return &medialive.TimecodeConfig{}
 	}
+	return nil
 }
 
 func expandVideoDescriptions(videoDescriptions []interface{}) []*medialive.VideoDescription {
@@ -571,7 +1660,7 @@ func expandVideoDescriptions(videoDescriptions []interface{}) []*medialive.Video
 		r := descs.(map[string]interface{})
 
 		result = append(result, &medialive.VideoDescription{
-			CodecSettings:   expandVideoCodecSettings(r["codec_settings"].(*schema.Set)),
+			CodecSettings:   expandVideoCodecSettings(r["codec_settings"].([]interface{})),
 			Height:          aws.Int64(int64(r["height"].(int))),
 			Name:            aws.String(r["name"].(string)),
 			RespondToAfd:    aws.String(r["respond_to_afd"].(string)),
@@ -583,21 +1672,70 @@ func expandVideoDescriptions(videoDescriptions []interface{}) []*medialive.Video
 	return result
 }
 
-func expandVideoCodecSettings(s *schema.Set) *medialive.VideoCodecSettings {
-	if s.Len() > 0 {
-		rawVideoCodecSettings := s.List()[0].(map[string]interface{})
-		return &medialive.VideoCodecSettings{
-			H264Settings: expandH264Settings(rawVideoCodecSettings["h264_settings"].(*schema.Set)),
+func expandVideoCodecSettings(s []interface{}) *medialive.VideoCodecSettings {
+	if len(s) > 0 {
+		rawVideoCodecSettings := s[0].(map[string]interface{})
+		result := &medialive.VideoCodecSettings{}
+		if v := rawVideoCodecSettings["h264_settings"].([]interface{}); len(v) > 0 {
+			result.H264Settings = expandH264Settings(v)
 		}
-	} else {
-		log.Printf("[WARN] MediaLive Channel: VideoCodecSettings can not be found")
-		return &medialive.VideoCodecSettings{}
+		if v := rawVideoCodecSettings["h265_settings"].([]interface{}); len(v) > 0 {
+			result.H265Settings = expandH265Settings(v)
+		}
+		if v := rawVideoCodecSettings["mpeg2_settings"].([]interface{}); len(v) > 0 {
+			result.Mpeg2Settings = expandMpeg2Settings(v)
+		}
+		if v := rawVideoCodecSettings["frame_capture_settings"].([]interface{}); len(v) > 0 {
+			result.FrameCaptureSettings = expandFrameCaptureSettings(v)
+		}
+		if v := rawVideoCodecSettings["av1_settings"].([]interface{}); len(v) > 0 {
+			result.Av1Settings = expandAv1Settings(v)
+		}
+		return result
+	}
+	return nil
+}
+
+// expandAv1Settings expands this provider's minimal AV1Settings coverage --
+// see the av1_settings schema comment.
+func expandAv1Settings(s []interface{}) *medialive.Av1Settings {
+	rawSettings := s[0].(map[string]interface{})
+	result := &medialive.Av1Settings{
+		FramerateDenominator: aws.Int64(int64(rawSettings["framerate_denominator"].(int))),
+		FramerateNumerator:   aws.Int64(int64(rawSettings["framerate_numerator"].(int))),
+	}
+	if v := rawSettings["gop_size"].(float64); v > 0 {
+		result.GopSize = aws.Float64(v)
+	}
+	if v := rawSettings["qvbr_quality_level"].(int); v > 0 {
+		result.QvbrQualityLevel = aws.Int64(int64(v))
+	}
+	if v := rawSettings["rate_control_mode"].(string); v != "" {
+		result.RateControlMode = aws.String(v)
+	}
+	if v := rawSettings["scene_change_detect"].(string); v != "" {
+		result.SceneChangeDetect = aws.String(v)
+	}
+	return result
+}
+
+func expandFrameCaptureSettings(s []interface{}) *medialive.FrameCaptureSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
+		result := &medialive.FrameCaptureSettings{
+			CaptureInterval: aws.Int64(int64(settings["capture_interval"].(int))),
+		}
+		if v := settings["capture_interval_units"].(string); v != "" {
+			result.CaptureIntervalUnits = aws.String(v)
+		}
+		return result
 	}
+	return nil
 }
 
-func expandH264Settings(s *schema.Set) *medialive.H264Settings {
-	if s.Len() > 0 {
-		rawSettings := s.List()[0].(map[string]interface{})
+func expandH264Settings(s []interface{}) *medialive.H264Settings {
+	if len(s) > 0 {
+		rawSettings := s[0].(map[string]interface{})
 		h264Settings := &medialive.H264Settings{
 			AdaptiveQuantization: aws.String(rawSettings["adaptive_quantization"].(string)),
 			AfdSignaling:         aws.String(rawSettings["afd_signaling"].(string)),
@@ -606,6 +1744,7 @@ func expandH264Settings(s *schema.Set) *medialive.H264Settings {
 			BufSize:              aws.Int64(int64(rawSettings["buf_size"].(int))),
 			ColorMetadata:        aws.String(rawSettings["color_metadata"].(string)),
 			EntropyEncoding:      aws.String(rawSettings["entropy_encoding"].(string)),
+			FixedAfd:             aws.String(rawSettings["fixed_afd"].(string)),
 			FlickerAq:            aws.String(rawSettings["flicker_aq"].(string)),
 			ForceFieldPictures:   aws.String(rawSettings["force_field_pictures"].(string)),
 			GopBReference:        aws.String(rawSettings["gop_b_reference"].(string)),
@@ -620,9 +1759,11 @@ func expandH264Settings(s *schema.Set) *medialive.H264Settings {
 			QualityLevel:         aws.String(rawSettings["quality_level"].(string)),
 			Profile:              aws.String(rawSettings["profile"].(string)),
 			RateControlMode:      aws.String(rawSettings["rate_control_mode"].(string)),
+			ScanType:             aws.String(rawSettings["scan_type"].(string)),
 			Syntax:               aws.String(rawSettings["syntax"].(string)),
 			SceneChangeDetect:    aws.String(rawSettings["scene_change_detect"].(string)),
 			SpatialAq:            aws.String(rawSettings["spatial_aq"].(string)),
+			SubgopLength:         aws.String(rawSettings["subgop_length"].(string)),
 			TemporalAq:           aws.String(rawSettings["temporal_aq"].(string)),
 			TimecodeInsertion:    aws.String(rawSettings["timecode_insertion"].(string)),
 		}
@@ -633,17 +1774,194 @@ func expandH264Settings(s *schema.Set) *medialive.H264Settings {
 			h264Settings.FramerateDenominator = aws.Int64(int64(rawSettings["framerate_denominator"].(int)))
 			h264Settings.FramerateNumerator = aws.Int64(int64(rawSettings["framerate_numerator"].(int)))
 		}
+		if v := rawSettings["max_bitrate"].(int); v > 0 {
+			h264Settings.MaxBitrate = aws.Int64(int64(v))
+		}
+		if v := rawSettings["min_i_interval"].(int); v > 0 {
+			h264Settings.MinIInterval = aws.Int64(int64(v))
+		}
+		if v := rawSettings["par_denominator"].(int); v > 0 {
+			h264Settings.ParDenominator = aws.Int64(int64(v))
+		}
+		if v := rawSettings["par_numerator"].(int); v > 0 {
+			h264Settings.ParNumerator = aws.Int64(int64(v))
+		}
+		if v := rawSettings["qvbr_quality_level"].(int); v > 0 {
+			h264Settings.QvbrQualityLevel = aws.Int64(int64(v))
+		}
+		if v := rawSettings["slices"].(int); v > 0 {
+			h264Settings.Slices = aws.Int64(int64(v))
+		}
+		if v := rawSettings["softness"].(int); v > 0 {
+			h264Settings.Softness = aws.Int64(int64(v))
+		}
+		if v := rawSettings["color_space_settings"].([]interface{}); len(v) > 0 {
+			h264Settings.ColorSpaceSettings = expandH264ColorSpaceSettings(v)
+		}
+		if v := rawSettings["filter_settings"].([]interface{}); len(v) > 0 {
+			h264Settings.FilterSettings = expandH264FilterSettings(v)
+		}
 		return h264Settings
-	} else {
-		log.Printf("[ERROR] MediaLive Channel: H264Settings can not be found")
-		return &medialive.H264Settings{}
 	}
+	return nil
+}
+
+func expandH264ColorSpaceSettings(s []interface{}) *medialive.H264ColorSpaceSettings {
+	settings := s[0].(map[string]interface{})
+	result := &medialive.H264ColorSpaceSettings{}
+	if v := settings["color_space_passthrough_settings"].([]interface{}); len(v) > 0 {
+		result.ColorSpacePassthroughSettings = &medialive.ColorSpacePassthroughSettings{}
+	}
+	if v := settings["rec_601_settings"].([]interface{}); len(v) > 0 {
+		result.Rec601Settings = &medialive.Rec601Settings{}
+	}
+	if v := settings["rec_709_settings"].([]interface{}); len(v) > 0 {
+		result.Rec709Settings = &medialive.Rec709Settings{}
+	}
+	return result
+}
+
+func expandH264FilterSettings(s []interface{}) *medialive.H264FilterSettings {
+	settings := s[0].(map[string]interface{})
+	result := &medialive.H264FilterSettings{}
+	if v := settings["temporal_filter_settings"].([]interface{}); len(v) > 0 {
+		result.TemporalFilterSettings = expandTemporalFilterSettings(v)
+	}
+	return result
+}
+
+func expandTemporalFilterSettings(s []interface{}) *medialive.TemporalFilterSettings {
+	settings := s[0].(map[string]interface{})
+	result := &medialive.TemporalFilterSettings{}
+	if v := settings["post_filter_sharpening"].(string); v != "" {
+		result.PostFilterSharpening = aws.String(v)
+	}
+	if v := settings["strength"].(string); v != "" {
+		result.Strength = aws.String(v)
+	}
+	return result
+}
+
+func expandH265Settings(s []interface{}) *medialive.H265Settings {
+	rawSettings := s[0].(map[string]interface{})
+	h265Settings := &medialive.H265Settings{
+		Bitrate:                     aws.Int64(int64(rawSettings["bitrate"].(int))),
+		FramerateDenominator:        aws.Int64(int64(rawSettings["framerate_denominator"].(int))),
+		FramerateNumerator:          aws.Int64(int64(rawSettings["framerate_numerator"].(int))),
+		AdaptiveQuantization:        aws.String(rawSettings["adaptive_quantization"].(string)),
+		AfdSignaling:                aws.String(rawSettings["afd_signaling"].(string)),
+		AlternativeTransferFunction: aws.String(rawSettings["alternative_transfer_function"].(string)),
+		ColorMetadata:               aws.String(rawSettings["color_metadata"].(string)),
+		FixedAfd:                    aws.String(rawSettings["fixed_afd"].(string)),
+		FlickerAq:                   aws.String(rawSettings["flicker_aq"].(string)),
+		GopClosedCadence:            aws.Int64(int64(rawSettings["gop_closed_cadence"].(int))),
+		GopSize:                     aws.Float64(rawSettings["gop_size"].(float64)),
+		GopSizeUnits:                aws.String(rawSettings["gop_size_units"].(string)),
+		Level:                       aws.String(rawSettings["level"].(string)),
+		LookAheadRateControl:        aws.String(rawSettings["look_ahead_rate_control"].(string)),
+		Profile:                     aws.String(rawSettings["profile"].(string)),
+		RateControlMode:             aws.String(rawSettings["rate_control_mode"].(string)),
+		ScanType:                    aws.String(rawSettings["scan_type"].(string)),
+		SceneChangeDetect:           aws.String(rawSettings["scene_change_detect"].(string)),
+		Tier:                        aws.String(rawSettings["tier"].(string)),
+		TimecodeInsertion:           aws.String(rawSettings["timecode_insertion"].(string)),
+	}
+	if v := rawSettings["buf_size"].(int); v > 0 {
+		h265Settings.BufSize = aws.Int64(int64(v))
+	}
+	if v := rawSettings["max_bitrate"].(int); v > 0 {
+		h265Settings.MaxBitrate = aws.Int64(int64(v))
+	}
+	if v := rawSettings["min_i_interval"].(int); v > 0 {
+		h265Settings.MinIInterval = aws.Int64(int64(v))
+	}
+	if v := rawSettings["par_denominator"].(int); v > 0 {
+		h265Settings.ParDenominator = aws.Int64(int64(v))
+	}
+	if v := rawSettings["par_numerator"].(int); v > 0 {
+		h265Settings.ParNumerator = aws.Int64(int64(v))
+	}
+	if v := rawSettings["qvbr_quality_level"].(int); v > 0 {
+		h265Settings.QvbrQualityLevel = aws.Int64(int64(v))
+	}
+	if v := rawSettings["slices"].(int); v > 0 {
+		h265Settings.Slices = aws.Int64(int64(v))
+	}
+	if v := rawSettings["tile_height"].(int); v > 0 {
+		h265Settings.TileHeight = aws.Int64(int64(v))
+	}
+	if v := rawSettings["tile_width"].(int); v > 0 {
+		h265Settings.TileWidth = aws.Int64(int64(v))
+	}
+	if v := rawSettings["mv_over_picture_boundaries"].(string); v != "" {
+		h265Settings.MvOverPictureBoundaries = aws.String(v)
+	}
+	if v := rawSettings["mv_temporal_predictor"].(string); v != "" {
+		h265Settings.MvTemporalPredictor = aws.String(v)
+	}
+	if v := rawSettings["color_space_settings"].([]interface{}); len(v) > 0 {
+		h265Settings.ColorSpaceSettings = expandH265ColorSpaceSettings(v)
+	}
+	if v := rawSettings["bandwidth_reduction_filter"].([]interface{}); len(v) > 0 {
+		h265Settings.FilterSettings = &medialive.H265FilterSettings{
+			BandwidthReductionFilterSettings: expandTemporalFilterSettings(v),
+		}
+	}
+	return h265Settings
+}
+
+func expandH265ColorSpaceSettings(s []interface{}) *medialive.H265ColorSpaceSettings {
+	settings := s[0].(map[string]interface{})
+	result := &medialive.H265ColorSpaceSettings{}
+	if v := settings["color_space_passthrough_settings"].([]interface{}); len(v) > 0 {
+		result.ColorSpacePassthroughSettings = &medialive.ColorSpacePassthroughSettings{}
+	}
+	if v := settings["dolby_vision_81_settings"].([]interface{}); len(v) > 0 {
+		result.DolbyVision81Settings = &medialive.DolbyVision81Settings{}
+	}
+	if v := settings["hdr10_settings"].([]interface{}); len(v) > 0 {
+		result.Hdr10Settings = expandHdr10Settings(v)
+	}
+	if v := settings["rec_601_settings"].([]interface{}); len(v) > 0 {
+		result.Rec601Settings = &medialive.Rec601Settings{}
+	}
+	if v := settings["rec_709_settings"].([]interface{}); len(v) > 0 {
+		result.Rec709Settings = &medialive.Rec709Settings{}
+	}
+	return result
+}
+
+func expandHdr10Settings(s []interface{}) *medialive.Hdr10Settings {
+	settings := s[0].(map[string]interface{})
+	result := &medialive.Hdr10Settings{}
+	if v := settings["max_cll"].(int); v > 0 {
+		result.MaxCll = aws.Int64(int64(v))
+	}
+	if v := settings["max_fall"].(int); v > 0 {
+		result.MaxFall = aws.Int64(int64(v))
+	}
+	return result
+}
+
+func expandMpeg2Settings(s []interface{}) *medialive.Mpeg2Settings {
+	rawSettings := s[0].(map[string]interface{})
+	result := &medialive.Mpeg2Settings{
+		FramerateDenominator: aws.Int64(int64(rawSettings["framerate_denominator"].(int))),
+		FramerateNumerator:   aws.Int64(int64(rawSettings["framerate_numerator"].(int))),
+		AdaptiveQuantization: aws.String(rawSettings["adaptive_quantization"].(string)),
+		GopClosedCadence:     aws.Int64(int64(rawSettings["gop_closed_cadence"].(int))),
+		GopNumBFrames:        aws.Int64(int64(rawSettings["gop_num_b_frames"].(int))),
+		GopSize:              aws.Float64(rawSettings["gop_size"].(float64)),
+		GopSizeUnits:         aws.String(rawSettings["gop_size_units"].(string)),
+		ScanType:             aws.String(rawSettings["scan_type"].(string)),
+	}
+	return result
 }
 
-func expandRtmpGroupSettings(s *schema.Set) *medialive.RtmpGroupSettings {
-	if s.Len() > 0 {
-		settings := s.List()[0].(map[string]interface{})
-		return &medialive.RtmpGroupSettings{
+func expandRtmpGroupSettings(s []interface{}) *medialive.RtmpGroupSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
+		result := &medialive.RtmpGroupSettings{
 			AuthenticationScheme: aws.String(settings["authentication_scheme"].(string)),
 			CacheFullBehavior:    aws.String(settings["cache_full_behavior"].(string)),
 			CacheLength:          aws.Int64(int64(settings["cache_length"].(int))),
@@ -651,30 +1969,35 @@ func expandRtmpGroupSettings(s *schema.Set) *medialive.RtmpGroupSettings {
 			InputLossAction:      aws.String(settings["input_loss_action"].(string)),
 			RestartDelay:         aws.Int64(int64(settings["restart_delay"].(int))),
 		}
-	} else {
-		log.Printf("[ERROR] MediaLive Channel: RtmpGroupSettings can not be found")
-		return &medialive.RtmpGroupSettings{}
+		if v := settings["ad_markers"].([]interface{}); len(v) > 0 {
+			result.AdMarkers = expandStringList(v)
+		}
+		return result
 	}
+	return nil
 }
 
-func expandRtmpOutputSettings(s *schema.Set) *medialive.RtmpOutputSettings {
-	if s.Len() > 0 {
-		settings := s.List()[0].(map[string]interface{})
+func expandRtmpOutputSettings(s []interface{}) *medialive.RtmpOutputSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
 		return &medialive.RtmpOutputSettings{
 			CertificateMode:         aws.String(settings["certificate_mode"].(string)),
 			ConnectionRetryInterval: aws.Int64(int64(settings["connection_retry_interval"].(int))),
 			NumRetries:              aws.Int64(int64(settings["num_retries"].(int))),
-			Destination:             expandRtmpOutputDestination(settings["destination"].(*schema.Set)),
+			Destination:             expandRtmpOutputDestination(settings["destination"].([]interface{})),
 		}
-	} else {
-		log.Printf("[ERROR] MediaLive Channel: RtmpOutputSettings can not be found")
-		return &medialive.RtmpOutputSettings{}
 	}
+	return nil
 }
 
-func expandRtmpOutputDestination(s *schema.Set) *medialive.OutputLocationRef {
-	if s.Len() > 0 {
-		settings := s.List()[0].(map[string]interface{})
+// expandRtmpOutputDestination expands a RTMP output's destination ref.
+// SRT is not exposed as an output group here -- the MediaLive API version
+// this provider is built against only offers SRT as an input type
+// (SRT_CALLER/SRT_LISTENER); there is no SrtGroupSettings/SrtOutputSettings
+// counterpart in the output group settings union alongside RtmpGroupSettings.
+func expandRtmpOutputDestination(s []interface{}) *medialive.OutputLocationRef {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
 		return &medialive.OutputLocationRef{
 			DestinationRefId: aws.String(settings["destination_ref_id"].(string)),
 		}
@@ -683,6 +2006,210 @@ func expandRtmpOutputDestination(s *schema.Set) *medialive.OutputLocationRef {
 	}
 }
 
+func expandCmafIngestOutputSettings(s []interface{}) *medialive.CmafIngestOutputSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
+		return &medialive.CmafIngestOutputSettings{
+			NameModifier: aws.String(settings["name_modifier"].(string)),
+		}
+	}
+	return nil
+}
+
+func expandFrameCaptureOutputSettings(s []interface{}) *medialive.FrameCaptureOutputSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
+		return &medialive.FrameCaptureOutputSettings{
+			NameModifier: aws.String(settings["name_modifier"].(string)),
+		}
+	}
+	return nil
+}
+
+func expandMsSmoothOutputSettings(s []interface{}) *medialive.MsSmoothOutputSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
+		result := &medialive.MsSmoothOutputSettings{
+			NameModifier: aws.String(settings["name_modifier"].(string)),
+		}
+		if v := settings["h_265_packaging_type"].(string); v != "" {
+			result.H265PackagingType = aws.String(v)
+		}
+		return result
+	}
+	return nil
+}
+
+func expandUdpOutputSettings(s []interface{}) *medialive.UdpOutputSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
+		result := &medialive.UdpOutputSettings{
+			Destination: expandHlsDestinationRef(settings["destination"].([]interface{})),
+		}
+		if v := settings["buffer_msec"].(int); v > 0 {
+			result.BufferMsec = aws.Int64(int64(v))
+		}
+		if v := settings["fec_output_settings"].([]interface{}); len(v) > 0 {
+			result.FecOutputSettings = expandFecOutputSettings(v)
+		}
+		if v := settings["container_settings"].([]interface{}); len(v) > 0 {
+			containerSettings := v[0].(map[string]interface{})
+			result.ContainerSettings = &medialive.UdpContainerSettings{
+				M2tsSettings: expandM2tsSettings(containerSettings["m2ts_settings"].([]interface{})),
+			}
+		}
+		return result
+	}
+	return nil
+}
+
+// expandM2tsSettings expands the M2TS container settings shared by
+// udp_output_settings and archive_output_settings' container_settings
+// blocks.
+func expandM2tsSettings(s []interface{}) *medialive.M2tsSettings {
+	if len(s) == 0 {
+		return nil
+	}
+	settings := s[0].(map[string]interface{})
+	result := &medialive.M2tsSettings{}
+	if v := settings["absent_input_audio_behavior"].(string); v != "" {
+		result.AbsentInputAudioBehavior = aws.String(v)
+	}
+	if v := settings["arib"].(string); v != "" {
+		result.Arib = aws.String(v)
+	}
+	if v := settings["audio_buffer_model"].(string); v != "" {
+		result.AudioBufferModel = aws.String(v)
+	}
+	if v := settings["audio_frames_per_pes"].(int); v > 0 {
+		result.AudioFramesPerPes = aws.Int64(int64(v))
+	}
+	if v := settings["audio_stream_type"].(string); v != "" {
+		result.AudioStreamType = aws.String(v)
+	}
+	if v := settings["bitrate"].(int); v > 0 {
+		result.Bitrate = aws.Int64(int64(v))
+	}
+	if v := settings["buffer_model"].(string); v != "" {
+		result.BufferModel = aws.String(v)
+	}
+	if v := settings["ebif"].(string); v != "" {
+		result.Ebif = aws.String(v)
+	}
+	if v := settings["ebp_audio_interval"].(string); v != "" {
+		result.EbpAudioInterval = aws.String(v)
+	}
+	if v := settings["ebp_lifetime"].(float64); v != 0 {
+		result.EbpLifetime = aws.Float64(v)
+	}
+	if v := settings["ebp_placement"].(string); v != "" {
+		result.EbpPlacement = aws.String(v)
+	}
+	if v := settings["es_rate_in_pes"].(string); v != "" {
+		result.EsRateInPes = aws.String(v)
+	}
+	if v := settings["fragment_time"].(float64); v != 0 {
+		result.FragmentTime = aws.Float64(v)
+	}
+	if v := settings["nielsen_id3_behavior"].(string); v != "" {
+		result.NielsenId3Behavior = aws.String(v)
+	}
+	if v := settings["null_packet_bitrate"].(float64); v != 0 {
+		result.NullPacketBitrate = aws.Float64(v)
+	}
+	if v := settings["pat_interval"].(int); v > 0 {
+		result.PatInterval = aws.Int64(int64(v))
+	}
+	if v := settings["pcr_control"].(string); v != "" {
+		result.PcrControl = aws.String(v)
+	}
+	if v := settings["pcr_period"].(int); v > 0 {
+		result.PcrPeriod = aws.Int64(int64(v))
+	}
+	if v := settings["pcr_pid"].(int); v > 0 {
+		result.PcrPid = aws.Int64(int64(v))
+	}
+	if v := settings["pmt_interval"].(int); v > 0 {
+		result.PmtInterval = aws.Int64(int64(v))
+	}
+	if v := settings["pmt_pid"].(int); v > 0 {
+		result.PmtPid = aws.Int64(int64(v))
+	}
+	if v := settings["program_num"].(int); v > 0 {
+		result.ProgramNum = aws.Int64(int64(v))
+	}
+	if v := settings["rate_mode"].(string); v != "" {
+		result.RateMode = aws.String(v)
+	}
+	if v := settings["scte35_control"].(string); v != "" {
+		result.Scte35Control = aws.String(v)
+	}
+	if v := settings["scte35_pid"].(int); v > 0 {
+		result.Scte35Pid = aws.Int64(int64(v))
+	}
+	if v := settings["segmentation_markers"].(string); v != "" {
+		result.SegmentationMarkers = aws.String(v)
+	}
+	if v := settings["segmentation_style"].(string); v != "" {
+		result.SegmentationStyle = aws.String(v)
+	}
+	if v := settings["segmentation_time"].(float64); v != 0 {
+		result.SegmentationTime = aws.Float64(v)
+	}
+	if v := settings["timed_metadata_behavior"].(string); v != "" {
+		result.TimedMetadataBehavior = aws.String(v)
+	}
+	if v := settings["timed_metadata_pid"].(int); v > 0 {
+		result.TimedMetadataPid = aws.Int64(int64(v))
+	}
+	if v := settings["transport_stream_id"].(int); v > 0 {
+		result.TransportStreamId = aws.Int64(int64(v))
+	}
+	if v := settings["video_pid"].(int); v > 0 {
+		result.VideoPid = aws.Int64(int64(v))
+	}
+	return result
+}
+
+func expandFecOutputSettings(s []interface{}) *medialive.FecOutputSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
+		result := &medialive.FecOutputSettings{}
+		if v := settings["column_depth"].(int); v > 0 {
+			result.ColumnDepth = aws.Int64(int64(v))
+		}
+		if v := settings["include_fec"].(string); v != "" {
+			result.IncludeFec = aws.String(v)
+		}
+		if v := settings["row_length"].(int); v > 0 {
+			result.RowLength = aws.Int64(int64(v))
+		}
+		return result
+	}
+	return nil
+}
+
+func expandArchiveOutputSettings(s []interface{}) *medialive.ArchiveOutputSettings {
+	if len(s) > 0 {
+		settings := s[0].(map[string]interface{})
+		result := &medialive.ArchiveOutputSettings{}
+		if v := settings["extension"].(string); v != "" {
+			result.Extension = aws.String(v)
+		}
+		if v := settings["name_modifier"].(string); v != "" {
+			result.NameModifier = aws.String(v)
+		}
+		if v := settings["container_settings"].([]interface{}); len(v) > 0 {
+			containerSettings := v[0].(map[string]interface{})
+			result.ContainerSettings = &medialive.ArchiveContainerSettings{
+				M2tsSettings: expandM2tsSettings(containerSettings["m2ts_settings"].([]interface{})),
+			}
+		}
+		return result
+	}
+	return nil
+}
+
 func expandChannelMaintenanceCreate(tfList []interface{}) *medialive.MaintenanceCreateSettings {
 	if tfList == nil {
 		return nil
@@ -713,11 +2240,13 @@ func expandChannelMaintenanceUpdate(tfList []interface{}) *medialive.Maintenance
 	if v, ok := m["maintenance_start_time"].(string); ok && v != "" {
 		settings.MaintenanceStartTime = aws.String(v)
 	}
-	// NOTE: This field is only available in the update struct. To allow users to set a scheduled
-	// date on update, it may be worth adding to the base schema.
-	// if v, ok := m["maintenance_scheduled_date"].(string); ok && v != "" {
-	// 	settings.MaintenanceScheduledDate = aws.String(v)
-	// }
+	// MaintenanceScheduledDate is only honored by the update API; see
+	// resourceAwsMediaLiveChannelMaintenanceScheduleUpdate for the dedicated
+	// resource that lets operators bump this without touching the rest of
+	// the channel.
+	if v, ok := m["maintenance_scheduled_date"].(string); ok && v != "" {
+		settings.MaintenanceScheduledDate = aws.String(v)
+	}
 
 	return settings
 }
@@ -728,8 +2257,9 @@ func flattenChannelMaintenance(apiObject *medialive.MaintenanceStatus) []interfa
 	}
 
 	m := map[string]interface{}{
-		"maintenance_day":        apiObject.MaintenanceDay,
-		"maintenance_start_time": apiObject.MaintenanceStartTime,
+		"maintenance_day":            apiObject.MaintenanceDay,
+		"maintenance_start_time":     apiObject.MaintenanceStartTime,
+		"maintenance_scheduled_date": apiObject.MaintenanceScheduledDate,
 	}
 
 	return []interface{}{m}
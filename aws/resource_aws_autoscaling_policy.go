@@ -0,0 +1,1659 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"math/rand"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsAutoscalingPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAutoscalingPolicyCreate,
+		Read:   resourceAwsAutoscalingPolicyRead,
+		Update: resourceAwsAutoscalingPolicyUpdate,
+		Delete: resourceAwsAutoscalingPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAutoscalingPolicyImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			// Matches the 10-minute wait testAccCheckScalingPolicyDisappears
+			// has always used for a policy's backing resources to clear.
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"autoscaling_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"adjustment_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"policy_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "SimpleScaling",
+				ValidateFunc: validation.StringInSlice([]string{
+					"SimpleScaling",
+					"StepScaling",
+					"TargetTrackingScaling",
+					"PredictiveScaling",
+				}, false),
+			},
+
+			"cooldown": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"scaling_adjustment": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"step_adjustment"},
+			},
+
+			"min_adjustment_magnitude": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"step_adjustment": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"scaling_adjustment"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_interval_lower_bound": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+						},
+
+						"metric_interval_upper_bound": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+						},
+
+						"scaling_adjustment": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"metric_aggregation_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"estimated_instance_warmup": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"target_tracking_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"predefined_metric_specification": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"predefined_metric_type": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"resource_label": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+
+						"customized_metric_specification": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric_dimension": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+
+												"value": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+
+									"metric_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"namespace": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"statistic": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"unit": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									// metrics supports CloudWatch metric math (e.g. a ratio of
+									// two metrics) in lieu of a single metric_name/namespace/
+									// statistic tuple; exactly one of the two forms may be set.
+									"metrics": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"id": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+
+												"expression": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+
+												"label": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+
+												"return_data": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  true,
+												},
+
+												"metric_stat": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"metric": {
+																Type:     schema.TypeList,
+																Required: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"dimensions": {
+																			Type:     schema.TypeList,
+																			Optional: true,
+																			Elem: &schema.Resource{
+																				Schema: map[string]*schema.Schema{
+																					"name": {
+																						Type:     schema.TypeString,
+																						Required: true,
+																					},
+
+																					"value": {
+																						Type:     schema.TypeString,
+																						Required: true,
+																					},
+																				},
+																			},
+																		},
+
+																		"metric_name": {
+																			Type:     schema.TypeString,
+																			Required: true,
+																		},
+
+																		"namespace": {
+																			Type:     schema.TypeString,
+																			Required: true,
+																		},
+																	},
+																},
+															},
+
+															"stat": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+
+															"unit": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"target_value": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+
+						"disable_scale_in": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			// PredictiveScaling forecasts capacity ahead of need rather than
+			// reacting to an alarm; see predictive_scaling_configuration below.
+			"predictive_scaling_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_specification": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"target_value": {
+										Type:     schema.TypeFloat,
+										Required: true,
+									},
+
+									"predefined_metric_pair_specification": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"predefined_metric_type": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+
+												"resource_label": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+
+									"predefined_scaling_metric_specification": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"predefined_metric_type": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+
+												"resource_label": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+
+									"predefined_load_metric_specification": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"predefined_metric_type": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+
+												"resource_label": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+
+									"customized_scaling_metric_specification": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"metric_data_queries": metricDataQueriesSchema(),
+											},
+										},
+									},
+
+									"customized_load_metric_specification": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"metric_data_queries": metricDataQueriesSchema(),
+											},
+										},
+									},
+
+									"customized_capacity_metric_specification": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"metric_data_queries": metricDataQueriesSchema(),
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  autoscaling.PredictiveScalingModeForecastAndScale,
+							ValidateFunc: validation.StringInSlice([]string{
+								autoscaling.PredictiveScalingModeForecastAndScale,
+								autoscaling.PredictiveScalingModeForecastOnly,
+							}, false),
+						},
+
+						"scheduling_buffer_time": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+
+						"max_capacity_breach_behavior": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  autoscaling.PredictiveScalingMaxCapacityBreachBehaviorHonorMaxCapacity,
+							ValidateFunc: validation.StringInSlice([]string{
+								autoscaling.PredictiveScalingMaxCapacityBreachBehaviorHonorMaxCapacity,
+								autoscaling.PredictiveScalingMaxCapacityBreachBehaviorIncreaseMaxCapacity,
+							}, false),
+						},
+
+						"max_capacity_buffer": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			// alarm provisions companion CloudWatch alarms whose alarm_actions
+			// point at this policy, so Step/Simple scaling users don't have to
+			// wire up aws_cloudwatch_metric_alarm separately.
+			"alarm": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"comparison_operator": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"evaluation_periods": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						"threshold": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+
+						"period": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+
+						"metric_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"namespace": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"statistic": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"dimensions": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"treat_missing_data": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "missing",
+						},
+
+						// metric_query supports metric math in lieu of a single
+						// metric_name/namespace/statistic tuple, mirroring
+						// aws_cloudwatch_metric_alarm's metric_query.
+						"metric_query": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"expression": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"label": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"return_data": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+
+									"metric": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"metric_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+
+												"namespace": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+
+												"period": {
+													Type:     schema.TypeInt,
+													Required: true,
+												},
+
+												"stat": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+
+												"unit": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+
+												"dimensions": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// autoscalingPolicyAlarmManagedTag marks a CloudWatch alarm as owned by an
+// aws_autoscaling_policy resource, so Read only reconciles drift on alarms
+// it created and leaves unmanaged alarms alone.
+const autoscalingPolicyAlarmManagedTag = "aws:autoscaling:policy"
+
+// metricDataQueriesSchema is shared by the customized_* specification blocks
+// of predictive_scaling_configuration, each of which carries a list of
+// metric-math queries rather than a single metric tuple.
+func metricDataQueriesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+
+				"expression": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+
+				"label": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+
+				"return_data": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+
+				"metric_stat": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"metric": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"dimensions": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"name": {
+														Type:     schema.TypeString,
+														Required: true,
+													},
+
+													"value": {
+														Type:     schema.TypeString,
+														Required: true,
+													},
+												},
+											},
+										},
+
+										"metric_name": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+
+										"namespace": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+									},
+								},
+							},
+
+							"stat": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+
+							"unit": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsAutoscalingPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).autoscalingconn
+
+	params, err := resourceAwsAutoscalingPolicyPutScalingPolicyInput(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] AutoScaling PutScalingPolicy: %#v", params)
+	var resp *autoscaling.PutScalingPolicyOutput
+	err = autoscalingPolicyRetry(d.Timeout(schema.TimeoutCreate), func() error {
+		var err error
+		resp, err = conn.PutScalingPolicy(&params)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Error putting scaling policy: %s", err)
+	}
+
+	d.Set("arn", resp.PolicyARN)
+	d.SetId(d.Get("name").(string))
+
+	if err := resourceAwsAutoscalingPolicyReconcileAlarms(d, meta, aws.StringValue(resp.PolicyARN)); err != nil {
+		return err
+	}
+
+	return resourceAwsAutoscalingPolicyRead(d, meta)
+}
+
+func resourceAwsAutoscalingPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	p, err := getAwsAutoscalingPolicy(d, meta)
+	if err != nil {
+		return err
+	}
+	if p == nil {
+		log.Printf("[WARN] Autoscaling Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := resourceAwsAutoscalingPolicyReadAlarms(d, meta, aws.StringValue(p.PolicyARN)); err != nil {
+		return err
+	}
+
+	d.Set("adjustment_type", p.AdjustmentType)
+	d.Set("autoscaling_group_name", p.AutoScalingGroupName)
+	d.Set("arn", p.PolicyARN)
+	d.Set("cooldown", p.Cooldown)
+	d.Set("estimated_instance_warmup", p.EstimatedInstanceWarmup)
+	d.Set("metric_aggregation_type", p.MetricAggregationType)
+	d.Set("policy_type", p.PolicyType)
+	d.Set("min_adjustment_magnitude", p.MinAdjustmentMagnitude)
+	d.Set("name", p.PolicyName)
+	d.Set("scaling_adjustment", p.ScalingAdjustment)
+
+	if err := d.Set("step_adjustment", flattenStepAdjustments(p.StepAdjustments)); err != nil {
+		return fmt.Errorf("error setting step_adjustment: %s", err)
+	}
+
+	if err := d.Set("target_tracking_configuration", flattenTargetTrackingConfiguration(p.TargetTrackingConfiguration)); err != nil {
+		return fmt.Errorf("error setting target_tracking_configuration: %s", err)
+	}
+
+	if err := d.Set("predictive_scaling_configuration", flattenPredictiveScalingConfiguration(p.PredictiveScalingConfiguration)); err != nil {
+		return fmt.Errorf("error setting predictive_scaling_configuration: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAutoscalingPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).autoscalingconn
+
+	params, err := resourceAwsAutoscalingPolicyPutScalingPolicyInput(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Autoscaling Update Scaling Policy: %#v", params)
+	var resp *autoscaling.PutScalingPolicyOutput
+	err = autoscalingPolicyRetry(d.Timeout(schema.TimeoutUpdate), func() error {
+		var err error
+		resp, err = conn.PutScalingPolicy(&params)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating scaling policy: %s", err)
+	}
+
+	if err := resourceAwsAutoscalingPolicyReconcileAlarms(d, meta, aws.StringValue(resp.PolicyARN)); err != nil {
+		return err
+	}
+
+	return resourceAwsAutoscalingPolicyRead(d, meta)
+}
+
+func resourceAwsAutoscalingPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).autoscalingconn
+
+	p, err := getAwsAutoscalingPolicy(d, meta)
+	if err != nil {
+		return err
+	}
+	if p == nil {
+		return nil
+	}
+
+	if err := resourceAwsAutoscalingPolicyDeleteAlarms(d, meta); err != nil {
+		return err
+	}
+
+	params := autoscaling.DeletePolicyInput{
+		AutoScalingGroupName: aws.String(d.Get("autoscaling_group_name").(string)),
+		PolicyName:           aws.String(d.Get("name").(string)),
+	}
+
+	err = autoscalingPolicyRetry(d.Timeout(schema.TimeoutDelete), func() error {
+		_, err := conn.DeletePolicy(&params)
+		if err != nil && autoscalingPolicyMissingOnDelete(err) {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Autoscaling Scaling Policy: %s ", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceAwsAutoscalingPolicyPutScalingPolicyInput(d *schema.ResourceData) (autoscaling.PutScalingPolicyInput, error) {
+	var params = autoscaling.PutScalingPolicyInput{
+		AutoScalingGroupName: aws.String(d.Get("autoscaling_group_name").(string)),
+		PolicyName:           aws.String(d.Get("name").(string)),
+		PolicyType:           aws.String(d.Get("policy_type").(string)),
+	}
+
+	// This parameter is supported if the policy type is SimpleScaling or StepScaling.
+	if v, ok := d.GetOk("adjustment_type"); ok {
+		params.AdjustmentType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cooldown"); ok {
+		params.Cooldown = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("estimated_instance_warmup"); ok {
+		params.EstimatedInstanceWarmup = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("metric_aggregation_type"); ok {
+		params.MetricAggregationType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("min_adjustment_magnitude"); ok {
+		params.MinAdjustmentMagnitude = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("scaling_adjustment"); ok {
+		params.ScalingAdjustment = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("step_adjustment"); ok {
+		params.StepAdjustments = expandStepAdjustments(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("target_tracking_configuration"); ok {
+		config, err := expandTargetTrackingConfiguration(v.([]interface{}))
+		if err != nil {
+			return params, err
+		}
+		params.TargetTrackingConfiguration = config
+	}
+
+	if v, ok := d.GetOk("predictive_scaling_configuration"); ok {
+		params.PredictiveScalingConfiguration = expandPredictiveScalingConfiguration(v.([]interface{}))
+	}
+
+	return params, nil
+}
+
+func getAwsAutoscalingPolicy(d *schema.ResourceData, meta interface{}) (*autoscaling.ScalingPolicy, error) {
+	conn := meta.(*AWSClient).autoscalingconn
+
+	params := autoscaling.DescribePoliciesInput{
+		AutoScalingGroupName: aws.String(d.Get("autoscaling_group_name").(string)),
+		PolicyNames:          []*string{aws.String(d.Id())},
+	}
+
+	var resp *autoscaling.DescribePoliciesOutput
+	err := autoscalingPolicyRetry(d.Timeout(schema.TimeoutRead), func() error {
+		var err error
+		resp, err = conn.DescribePolicies(&params)
+		return err
+	})
+	if err != nil {
+		if isAWSErr(err, autoscaling.ErrCodeResourceContentionFault, "") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Error retrieving scaling policies: %s", err)
+	}
+
+	for _, policy := range resp.ScalingPolicies {
+		if aws.StringValue(policy.PolicyName) == d.Id() {
+			return policy, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func resourceAwsAutoscalingPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := resourceAwsAutoscalingPolicyParseImportId(d.Id())
+	if len(idParts) != 2 {
+		return nil, fmt.Errorf("expected ID in the form of autoscaling-group-name/policy-name, given: %q", d.Id())
+	}
+
+	d.Set("autoscaling_group_name", idParts[0])
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAwsAutoscalingPolicyParseImportId(id string) []string {
+	for i := len(id) - 1; i >= 0; i-- {
+		if id[i] == '/' {
+			return []string{id[:i], id[i+1:]}
+		}
+	}
+	return []string{id}
+}
+
+func expandStepAdjustments(tfList []interface{}) []*autoscaling.StepAdjustment {
+	var result []*autoscaling.StepAdjustment
+
+	for _, tfItem := range tfList {
+		m := tfItem.(map[string]interface{})
+
+		adjustment := &autoscaling.StepAdjustment{
+			ScalingAdjustment: aws.Int64(int64(m["scaling_adjustment"].(int))),
+		}
+
+		if v, ok := m["metric_interval_lower_bound"]; ok {
+			if v, null, _ := nullableFloat(v); !null {
+				adjustment.MetricIntervalLowerBound = aws.Float64(v)
+			}
+		}
+
+		if v, ok := m["metric_interval_upper_bound"]; ok {
+			if v, null, _ := nullableFloat(v); !null {
+				adjustment.MetricIntervalUpperBound = aws.Float64(v)
+			}
+		}
+
+		result = append(result, adjustment)
+	}
+
+	return result
+}
+
+// nullableFloat treats the Terraform SDK's zero-value float64(0) for an
+// unset Optional TypeFloat the same as "not provided", since step adjustment
+// bounds are meaningful only when explicitly configured.
+func nullableFloat(v interface{}) (float64, bool, error) {
+	f, ok := v.(float64)
+	if !ok {
+		return 0, true, nil
+	}
+	if f == 0 {
+		return 0, true, nil
+	}
+	return f, false, nil
+}
+
+func flattenStepAdjustments(adjustments []*autoscaling.StepAdjustment) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(adjustments))
+	for _, a := range adjustments {
+		m := map[string]interface{}{
+			"scaling_adjustment": aws.Int64Value(a.ScalingAdjustment),
+		}
+		if a.MetricIntervalLowerBound != nil {
+			m["metric_interval_lower_bound"] = aws.Float64Value(a.MetricIntervalLowerBound)
+		}
+		if a.MetricIntervalUpperBound != nil {
+			m["metric_interval_upper_bound"] = aws.Float64Value(a.MetricIntervalUpperBound)
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
+func expandTargetTrackingConfiguration(tfList []interface{}) (*autoscaling.TargetTrackingConfiguration, error) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil, nil
+	}
+	m := tfList[0].(map[string]interface{})
+
+	config := &autoscaling.TargetTrackingConfiguration{
+		TargetValue: aws.Float64(m["target_value"].(float64)),
+	}
+
+	if v, ok := m["disable_scale_in"]; ok {
+		config.DisableScaleIn = aws.Bool(v.(bool))
+	}
+
+	if v, ok := m["predefined_metric_specification"].([]interface{}); ok && len(v) > 0 {
+		spec := v[0].(map[string]interface{})
+		config.PredefinedMetricSpecification = &autoscaling.PredefinedMetricSpecification{
+			PredefinedMetricType: aws.String(spec["predefined_metric_type"].(string)),
+		}
+		if v, ok := spec["resource_label"].(string); ok && v != "" {
+			config.PredefinedMetricSpecification.ResourceLabel = aws.String(v)
+		}
+	}
+
+	if v, ok := m["customized_metric_specification"].([]interface{}); ok && len(v) > 0 {
+		spec := v[0].(map[string]interface{})
+
+		classicSet := spec["metric_name"].(string) != "" || spec["namespace"].(string) != "" || spec["statistic"].(string) != "" || len(spec["metric_dimension"].([]interface{})) > 0
+		metrics, _ := spec["metrics"].([]interface{})
+
+		if classicSet && len(metrics) > 0 {
+			return nil, fmt.Errorf("customized_metric_specification: only one of the classic metric_name/namespace/statistic fields or metrics may be set, not both")
+		}
+
+		customSpec := &autoscaling.CustomizedMetricSpecification{}
+
+		if classicSet {
+			if v, ok := spec["metric_name"].(string); ok && v != "" {
+				customSpec.MetricName = aws.String(v)
+			}
+			if v, ok := spec["namespace"].(string); ok && v != "" {
+				customSpec.Namespace = aws.String(v)
+			}
+			if v, ok := spec["statistic"].(string); ok && v != "" {
+				customSpec.Statistic = aws.String(v)
+			}
+			if v, ok := spec["unit"].(string); ok && v != "" {
+				customSpec.Unit = aws.String(v)
+			}
+			if v, ok := spec["metric_dimension"].([]interface{}); ok && len(v) > 0 {
+				customSpec.Dimensions = expandAutoscalingMetricDimensions(v)
+			}
+		}
+
+		if len(metrics) > 0 {
+			returning := 0
+			for _, tfItem := range metrics {
+				if tfItem.(map[string]interface{})["return_data"].(bool) {
+					returning++
+				}
+			}
+			if returning != 1 {
+				return nil, fmt.Errorf("customized_metric_specification.metrics: exactly one query must have return_data = true, got %d", returning)
+			}
+
+			customSpec.Metrics = expandAutoscalingMetricDataQueries(metrics)
+		}
+
+		config.CustomizedMetricSpecification = customSpec
+	}
+
+	return config, nil
+}
+
+func expandAutoscalingMetricDimensions(tfList []interface{}) []*autoscaling.MetricDimension {
+	var result []*autoscaling.MetricDimension
+	for _, tfItem := range tfList {
+		m := tfItem.(map[string]interface{})
+		result = append(result, &autoscaling.MetricDimension{
+			Name:  aws.String(m["name"].(string)),
+			Value: aws.String(m["value"].(string)),
+		})
+	}
+	return result
+}
+
+func expandAutoscalingMetricDataQueries(tfList []interface{}) []*autoscaling.TargetTrackingMetricDataQuery {
+	var result []*autoscaling.TargetTrackingMetricDataQuery
+
+	for _, tfItem := range tfList {
+		m := tfItem.(map[string]interface{})
+
+		query := &autoscaling.TargetTrackingMetricDataQuery{
+			Id:         aws.String(m["id"].(string)),
+			ReturnData: aws.Bool(m["return_data"].(bool)),
+		}
+
+		if v, ok := m["expression"].(string); ok && v != "" {
+			query.Expression = aws.String(v)
+		}
+		if v, ok := m["label"].(string); ok && v != "" {
+			query.Label = aws.String(v)
+		}
+
+		if v, ok := m["metric_stat"].([]interface{}); ok && len(v) > 0 {
+			query.MetricStat = expandAutoscalingTargetTrackingMetricStat(v[0].(map[string]interface{}))
+		}
+
+		result = append(result, query)
+	}
+
+	return result
+}
+
+func expandAutoscalingTargetTrackingMetricStat(m map[string]interface{}) *autoscaling.TargetTrackingMetricStat {
+	stat := &autoscaling.TargetTrackingMetricStat{
+		Stat: aws.String(m["stat"].(string)),
+	}
+
+	if v, ok := m["unit"].(string); ok && v != "" {
+		stat.Unit = aws.String(v)
+	}
+
+	if v, ok := m["metric"].([]interface{}); ok && len(v) > 0 {
+		metric := v[0].(map[string]interface{})
+		stat.Metric = &autoscaling.Metric{
+			MetricName: aws.String(metric["metric_name"].(string)),
+			Namespace:  aws.String(metric["namespace"].(string)),
+		}
+		if dims, ok := metric["dimensions"].([]interface{}); ok && len(dims) > 0 {
+			stat.Metric.Dimensions = expandAutoscalingMetricDimensions(dims)
+		}
+	}
+
+	return stat
+}
+
+func flattenTargetTrackingConfiguration(config *autoscaling.TargetTrackingConfiguration) []map[string]interface{} {
+	if config == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"target_value":     aws.Float64Value(config.TargetValue),
+		"disable_scale_in": aws.BoolValue(config.DisableScaleIn),
+	}
+
+	if config.PredefinedMetricSpecification != nil {
+		m["predefined_metric_specification"] = []map[string]interface{}{
+			{
+				"predefined_metric_type": aws.StringValue(config.PredefinedMetricSpecification.PredefinedMetricType),
+				"resource_label":         aws.StringValue(config.PredefinedMetricSpecification.ResourceLabel),
+			},
+		}
+	}
+
+	if config.CustomizedMetricSpecification != nil {
+		spec := config.CustomizedMetricSpecification
+		customSpecMap := map[string]interface{}{
+			"metric_name": aws.StringValue(spec.MetricName),
+			"namespace":   aws.StringValue(spec.Namespace),
+			"statistic":   aws.StringValue(spec.Statistic),
+			"unit":        aws.StringValue(spec.Unit),
+		}
+
+		if len(spec.Dimensions) > 0 {
+			dimensions := make([]map[string]interface{}, 0, len(spec.Dimensions))
+			for _, d := range spec.Dimensions {
+				dimensions = append(dimensions, map[string]interface{}{
+					"name":  aws.StringValue(d.Name),
+					"value": aws.StringValue(d.Value),
+				})
+			}
+			customSpecMap["metric_dimension"] = dimensions
+		}
+
+		if len(spec.Metrics) > 0 {
+			customSpecMap["metrics"] = flattenAutoscalingMetricDataQueries(spec.Metrics)
+		}
+
+		m["customized_metric_specification"] = []map[string]interface{}{customSpecMap}
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenAutoscalingMetricDataQueries(queries []*autoscaling.TargetTrackingMetricDataQuery) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(queries))
+
+	for _, q := range queries {
+		m := map[string]interface{}{
+			"id":          aws.StringValue(q.Id),
+			"expression":  aws.StringValue(q.Expression),
+			"label":       aws.StringValue(q.Label),
+			"return_data": aws.BoolValue(q.ReturnData),
+		}
+
+		if q.MetricStat != nil {
+			statMap := map[string]interface{}{
+				"stat": aws.StringValue(q.MetricStat.Stat),
+				"unit": aws.StringValue(q.MetricStat.Unit),
+			}
+
+			if q.MetricStat.Metric != nil {
+				metricMap := map[string]interface{}{
+					"metric_name": aws.StringValue(q.MetricStat.Metric.MetricName),
+					"namespace":   aws.StringValue(q.MetricStat.Metric.Namespace),
+				}
+
+				if len(q.MetricStat.Metric.Dimensions) > 0 {
+					dimensions := make([]map[string]interface{}, 0, len(q.MetricStat.Metric.Dimensions))
+					for _, d := range q.MetricStat.Metric.Dimensions {
+						dimensions = append(dimensions, map[string]interface{}{
+							"name":  aws.StringValue(d.Name),
+							"value": aws.StringValue(d.Value),
+						})
+					}
+					metricMap["dimensions"] = dimensions
+				}
+
+				statMap["metric"] = []map[string]interface{}{metricMap}
+			}
+
+			m["metric_stat"] = []map[string]interface{}{statMap}
+		}
+
+		result = append(result, m)
+	}
+
+	return result
+}
+
+func expandPredictiveScalingConfiguration(tfList []interface{}) *autoscaling.PredictiveScalingConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+	m := tfList[0].(map[string]interface{})
+
+	config := &autoscaling.PredictiveScalingConfiguration{}
+
+	if v, ok := m["mode"].(string); ok && v != "" {
+		config.Mode = aws.String(v)
+	}
+	if v, ok := m["scheduling_buffer_time"].(int); ok && v != 0 {
+		config.SchedulingBufferTime = aws.Int64(int64(v))
+	}
+	if v, ok := m["max_capacity_breach_behavior"].(string); ok && v != "" {
+		config.MaxCapacityBreachBehavior = aws.String(v)
+	}
+	if v, ok := m["max_capacity_buffer"].(int); ok && v != 0 {
+		config.MaxCapacityBuffer = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["metric_specification"].([]interface{}); ok && len(v) > 0 {
+		config.MetricSpecifications = []*autoscaling.PredictiveScalingMetricSpecification{
+			expandPredictiveScalingMetricSpecification(v[0].(map[string]interface{})),
+		}
+	}
+
+	return config
+}
+
+func expandPredictiveScalingMetricSpecification(m map[string]interface{}) *autoscaling.PredictiveScalingMetricSpecification {
+	spec := &autoscaling.PredictiveScalingMetricSpecification{
+		TargetValue: aws.Float64(m["target_value"].(float64)),
+	}
+
+	if v, ok := m["predefined_metric_pair_specification"].([]interface{}); ok && len(v) > 0 {
+		p := v[0].(map[string]interface{})
+		spec.PredefinedMetricPairSpecification = &autoscaling.PredictiveScalingPredefinedMetricPair{
+			PredefinedMetricType: aws.String(p["predefined_metric_type"].(string)),
+			ResourceLabel:        stringOrNil(p["resource_label"]),
+		}
+	}
+
+	if v, ok := m["predefined_scaling_metric_specification"].([]interface{}); ok && len(v) > 0 {
+		p := v[0].(map[string]interface{})
+		spec.PredefinedScalingMetricSpecification = &autoscaling.PredictiveScalingPredefinedScalingMetric{
+			PredefinedMetricType: aws.String(p["predefined_metric_type"].(string)),
+			ResourceLabel:        stringOrNil(p["resource_label"]),
+		}
+	}
+
+	if v, ok := m["predefined_load_metric_specification"].([]interface{}); ok && len(v) > 0 {
+		p := v[0].(map[string]interface{})
+		spec.PredefinedLoadMetricSpecification = &autoscaling.PredictiveScalingPredefinedLoadMetric{
+			PredefinedMetricType: aws.String(p["predefined_metric_type"].(string)),
+			ResourceLabel:        stringOrNil(p["resource_label"]),
+		}
+	}
+
+	if v, ok := m["customized_scaling_metric_specification"].([]interface{}); ok && len(v) > 0 {
+		p := v[0].(map[string]interface{})
+		spec.CustomizedScalingMetricSpecification = &autoscaling.PredictiveScalingCustomizedScalingMetric{
+			MetricDataQueries: expandAutoscalingMetricDataQueries(p["metric_data_queries"].([]interface{})),
+		}
+	}
+
+	if v, ok := m["customized_load_metric_specification"].([]interface{}); ok && len(v) > 0 {
+		p := v[0].(map[string]interface{})
+		spec.CustomizedLoadMetricSpecification = &autoscaling.PredictiveScalingCustomizedLoadMetric{
+			MetricDataQueries: expandAutoscalingMetricDataQueries(p["metric_data_queries"].([]interface{})),
+		}
+	}
+
+	if v, ok := m["customized_capacity_metric_specification"].([]interface{}); ok && len(v) > 0 {
+		p := v[0].(map[string]interface{})
+		spec.CustomizedCapacityMetricSpecification = &autoscaling.PredictiveScalingCustomizedCapacityMetric{
+			MetricDataQueries: expandAutoscalingMetricDataQueries(p["metric_data_queries"].([]interface{})),
+		}
+	}
+
+	return spec
+}
+
+func stringOrNil(v interface{}) *string {
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return nil
+	}
+	return aws.String(s)
+}
+
+func flattenPredictiveScalingConfiguration(config *autoscaling.PredictiveScalingConfiguration) []map[string]interface{} {
+	if config == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"mode":                         aws.StringValue(config.Mode),
+		"scheduling_buffer_time":       aws.Int64Value(config.SchedulingBufferTime),
+		"max_capacity_breach_behavior": aws.StringValue(config.MaxCapacityBreachBehavior),
+		"max_capacity_buffer":          aws.Int64Value(config.MaxCapacityBuffer),
+	}
+
+	if len(config.MetricSpecifications) > 0 {
+		spec := config.MetricSpecifications[0]
+		specMap := map[string]interface{}{
+			"target_value": aws.Float64Value(spec.TargetValue),
+		}
+
+		if spec.PredefinedMetricPairSpecification != nil {
+			specMap["predefined_metric_pair_specification"] = []map[string]interface{}{
+				{
+					"predefined_metric_type": aws.StringValue(spec.PredefinedMetricPairSpecification.PredefinedMetricType),
+					"resource_label":         aws.StringValue(spec.PredefinedMetricPairSpecification.ResourceLabel),
+				},
+			}
+		}
+
+		if spec.PredefinedScalingMetricSpecification != nil {
+			specMap["predefined_scaling_metric_specification"] = []map[string]interface{}{
+				{
+					"predefined_metric_type": aws.StringValue(spec.PredefinedScalingMetricSpecification.PredefinedMetricType),
+					"resource_label":         aws.StringValue(spec.PredefinedScalingMetricSpecification.ResourceLabel),
+				},
+			}
+		}
+
+		if spec.PredefinedLoadMetricSpecification != nil {
+			specMap["predefined_load_metric_specification"] = []map[string]interface{}{
+				{
+					"predefined_metric_type": aws.StringValue(spec.PredefinedLoadMetricSpecification.PredefinedMetricType),
+					"resource_label":         aws.StringValue(spec.PredefinedLoadMetricSpecification.ResourceLabel),
+				},
+			}
+		}
+
+		if spec.CustomizedScalingMetricSpecification != nil {
+			specMap["customized_scaling_metric_specification"] = []map[string]interface{}{
+				{"metric_data_queries": flattenAutoscalingMetricDataQueries(spec.CustomizedScalingMetricSpecification.MetricDataQueries)},
+			}
+		}
+
+		if spec.CustomizedLoadMetricSpecification != nil {
+			specMap["customized_load_metric_specification"] = []map[string]interface{}{
+				{"metric_data_queries": flattenAutoscalingMetricDataQueries(spec.CustomizedLoadMetricSpecification.MetricDataQueries)},
+			}
+		}
+
+		if spec.CustomizedCapacityMetricSpecification != nil {
+			specMap["customized_capacity_metric_specification"] = []map[string]interface{}{
+				{"metric_data_queries": flattenAutoscalingMetricDataQueries(spec.CustomizedCapacityMetricSpecification.MetricDataQueries)},
+			}
+		}
+
+		m["metric_specification"] = []map[string]interface{}{specMap}
+	}
+
+	return []map[string]interface{}{m}
+}
+
+// resourceAwsAutoscalingPolicyReconcileAlarms creates or updates the CloudWatch
+// alarms configured in the "alarm" blocks, pointing each one's alarm_actions at
+// policyArn, and deletes any previously-managed alarms that were removed from
+// configuration.
+func resourceAwsAutoscalingPolicyReconcileAlarms(d *schema.ResourceData, meta interface{}, policyArn string) error {
+	conn := meta.(*AWSClient).cloudwatchconn
+
+	desired := d.Get("alarm").([]interface{})
+	desiredNames := make(map[string]bool, len(desired))
+
+	for _, tfItem := range desired {
+		m := tfItem.(map[string]interface{})
+		name := m["name"].(string)
+		desiredNames[name] = true
+
+		input := expandAutoscalingPolicyAlarmPutInput(m, d.Get("autoscaling_group_name").(string), d.Get("name").(string), policyArn)
+
+		log.Printf("[DEBUG] AutoScaling Policy Alarm PutMetricAlarm: %s", name)
+		if _, err := conn.PutMetricAlarm(input); err != nil {
+			return fmt.Errorf("error putting CloudWatch alarm %q for Autoscaling Policy %q: %s", name, d.Id(), err)
+		}
+	}
+
+	if !d.IsNewResource() {
+		o, _ := d.GetChange("alarm")
+		for _, tfItem := range o.([]interface{}) {
+			name := tfItem.(map[string]interface{})["name"].(string)
+			if !desiredNames[name] {
+				if _, err := conn.DeleteAlarms(&cloudwatch.DeleteAlarmsInput{
+					AlarmNames: aws.StringSlice([]string{name}),
+				}); err != nil {
+					return fmt.Errorf("error deleting stale CloudWatch alarm %q for Autoscaling Policy %q: %s", name, d.Id(), err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// resourceAwsAutoscalingPolicyDeleteAlarms removes all alarms managed by this
+// policy when the policy itself is destroyed.
+func resourceAwsAutoscalingPolicyDeleteAlarms(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchconn
+
+	alarms := d.Get("alarm").([]interface{})
+	if len(alarms) == 0 {
+		return nil
+	}
+
+	names := make([]*string, 0, len(alarms))
+	for _, tfItem := range alarms {
+		names = append(names, aws.String(tfItem.(map[string]interface{})["name"].(string)))
+	}
+
+	if _, err := conn.DeleteAlarms(&cloudwatch.DeleteAlarmsInput{AlarmNames: names}); err != nil {
+		return fmt.Errorf("error deleting CloudWatch alarms for Autoscaling Policy %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceAwsAutoscalingPolicyReadAlarms reconciles drift on the alarms this
+// policy manages only; an alarm whose AlarmDescription is not the
+// autoscalingPolicyAlarmManagedTag marker for this policy name is left out of
+// the alarm attribute even if its name matches a configured alarm.
+func resourceAwsAutoscalingPolicyReadAlarms(d *schema.ResourceData, meta interface{}, policyArn string) error {
+	conn := meta.(*AWSClient).cloudwatchconn
+
+	configured := d.Get("alarm").([]interface{})
+	if len(configured) == 0 {
+		return nil
+	}
+
+	names := make([]*string, 0, len(configured))
+	for _, tfItem := range configured {
+		names = append(names, aws.String(tfItem.(map[string]interface{})["name"].(string)))
+	}
+
+	resp, err := conn.DescribeAlarms(&cloudwatch.DescribeAlarmsInput{AlarmNames: names})
+	if err != nil {
+		return fmt.Errorf("error describing CloudWatch alarms for Autoscaling Policy %q: %s", d.Id(), err)
+	}
+
+	policyName := d.Get("name").(string)
+	result := make([]map[string]interface{}, 0, len(resp.MetricAlarms))
+	for _, alarm := range resp.MetricAlarms {
+		if !isAutoscalingPolicyManagedAlarm(alarm, policyName) {
+			continue
+		}
+		result = append(result, flattenAutoscalingPolicyAlarm(alarm))
+	}
+
+	if err := d.Set("alarm", result); err != nil {
+		return fmt.Errorf("error setting alarm: %s", err)
+	}
+
+	return nil
+}
+
+func isAutoscalingPolicyManagedAlarm(alarm *cloudwatch.MetricAlarm, policyName string) bool {
+	marker := autoscalingPolicyAlarmManagedTag + "=" + policyName
+	return aws.StringValue(alarm.AlarmDescription) == marker
+}
+
+func expandAutoscalingPolicyAlarmPutInput(m map[string]interface{}, asgName, policyName, policyArn string) *cloudwatch.PutMetricAlarmInput {
+	input := &cloudwatch.PutMetricAlarmInput{
+		AlarmName:          aws.String(m["name"].(string)),
+		AlarmDescription:   aws.String(autoscalingPolicyAlarmManagedTag + "=" + policyName),
+		AlarmActions:       aws.StringSlice([]string{policyArn}),
+		ComparisonOperator: aws.String(m["comparison_operator"].(string)),
+		EvaluationPeriods:  aws.Int64(int64(m["evaluation_periods"].(int))),
+		Threshold:          aws.Float64(m["threshold"].(float64)),
+		TreatMissingData:   aws.String(m["treat_missing_data"].(string)),
+	}
+
+	if v, ok := m["period"].(int); ok && v != 0 {
+		input.Period = aws.Int64(int64(v))
+	}
+
+	if metricQueries, ok := m["metric_query"].([]interface{}); ok && len(metricQueries) > 0 {
+		input.Metrics = expandAutoscalingPolicyAlarmMetricQueries(metricQueries)
+	} else {
+		input.MetricName = aws.String(m["metric_name"].(string))
+		input.Namespace = aws.String(m["namespace"].(string))
+		input.Statistic = aws.String(m["statistic"].(string))
+
+		dims := m["dimensions"].(map[string]interface{})
+		if len(dims) == 0 {
+			dims = map[string]interface{}{"AutoScalingGroupName": asgName}
+		}
+		input.Dimensions = expandAutoscalingPolicyAlarmDimensions(dims)
+	}
+
+	return input
+}
+
+func expandAutoscalingPolicyAlarmDimensions(m map[string]interface{}) []*cloudwatch.Dimension {
+	result := make([]*cloudwatch.Dimension, 0, len(m))
+	for k, v := range m {
+		result = append(result, &cloudwatch.Dimension{
+			Name:  aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+	return result
+}
+
+func expandAutoscalingPolicyAlarmMetricQueries(tfList []interface{}) []*cloudwatch.MetricDataQuery {
+	result := make([]*cloudwatch.MetricDataQuery, 0, len(tfList))
+
+	for _, tfItem := range tfList {
+		m := tfItem.(map[string]interface{})
+
+		query := &cloudwatch.MetricDataQuery{
+			Id:         aws.String(m["id"].(string)),
+			ReturnData: aws.Bool(m["return_data"].(bool)),
+		}
+
+		if v, ok := m["expression"].(string); ok && v != "" {
+			query.Expression = aws.String(v)
+		}
+		if v, ok := m["label"].(string); ok && v != "" {
+			query.Label = aws.String(v)
+		}
+
+		if v, ok := m["metric"].([]interface{}); ok && len(v) > 0 {
+			metric := v[0].(map[string]interface{})
+			query.MetricStat = &cloudwatch.MetricStat{
+				Metric: &cloudwatch.Metric{
+					MetricName: aws.String(metric["metric_name"].(string)),
+					Namespace:  aws.String(metric["namespace"].(string)),
+					Dimensions: expandAutoscalingPolicyAlarmDimensions(metric["dimensions"].(map[string]interface{})),
+				},
+				Period: aws.Int64(int64(metric["period"].(int))),
+				Stat:   aws.String(metric["stat"].(string)),
+			}
+			if unit, ok := metric["unit"].(string); ok && unit != "" {
+				query.MetricStat.Unit = aws.String(unit)
+			}
+		}
+
+		result = append(result, query)
+	}
+
+	return result
+}
+
+func flattenAutoscalingPolicyAlarm(alarm *cloudwatch.MetricAlarm) map[string]interface{} {
+	m := map[string]interface{}{
+		"name":                aws.StringValue(alarm.AlarmName),
+		"comparison_operator": aws.StringValue(alarm.ComparisonOperator),
+		"evaluation_periods":  aws.Int64Value(alarm.EvaluationPeriods),
+		"threshold":           aws.Float64Value(alarm.Threshold),
+		"treat_missing_data":  aws.StringValue(alarm.TreatMissingData),
+	}
+
+	if alarm.Period != nil {
+		m["period"] = aws.Int64Value(alarm.Period)
+	}
+
+	if len(alarm.Metrics) > 0 {
+		m["metric_query"] = flattenAutoscalingPolicyAlarmMetricQueries(alarm.Metrics)
+	} else {
+		m["metric_name"] = aws.StringValue(alarm.MetricName)
+		m["namespace"] = aws.StringValue(alarm.Namespace)
+		m["statistic"] = aws.StringValue(alarm.Statistic)
+		m["dimensions"] = flattenAutoscalingPolicyAlarmDimensionMap(alarm.Dimensions)
+	}
+
+	return m
+}
+
+func flattenAutoscalingPolicyAlarmDimensionMap(dims []*cloudwatch.Dimension) map[string]interface{} {
+	result := make(map[string]interface{}, len(dims))
+	for _, d := range dims {
+		result[aws.StringValue(d.Name)] = aws.StringValue(d.Value)
+	}
+	return result
+}
+
+func flattenAutoscalingPolicyAlarmMetricQueries(queries []*cloudwatch.MetricDataQuery) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(queries))
+
+	for _, q := range queries {
+		m := map[string]interface{}{
+			"id":          aws.StringValue(q.Id),
+			"expression":  aws.StringValue(q.Expression),
+			"label":       aws.StringValue(q.Label),
+			"return_data": aws.BoolValue(q.ReturnData),
+		}
+
+		if q.MetricStat != nil {
+			metric := map[string]interface{}{
+				"period": aws.Int64Value(q.MetricStat.Period),
+				"stat":   aws.StringValue(q.MetricStat.Stat),
+				"unit":   aws.StringValue(q.MetricStat.Unit),
+			}
+			if q.MetricStat.Metric != nil {
+				metric["metric_name"] = aws.StringValue(q.MetricStat.Metric.MetricName)
+				metric["namespace"] = aws.StringValue(q.MetricStat.Metric.Namespace)
+				metric["dimensions"] = flattenAutoscalingPolicyAlarmDimensionMap(q.MetricStat.Metric.Dimensions)
+			}
+			m["metric"] = []map[string]interface{}{metric}
+		}
+
+		result = append(result, m)
+	}
+
+	return result
+}
+
+const (
+	autoscalingPolicyRetryBaseDelay = 500 * time.Millisecond
+	autoscalingPolicyRetryMaxDelay  = 30 * time.Second
+)
+
+// autoscalingPolicyRetryable reports whether an AWS API error returned while
+// managing an aws_autoscaling_policy should be retried with backoff rather
+// than surfaced immediately.
+func autoscalingPolicyRetryable(err error) bool {
+	return isAWSErr(err, "Throttling", "") ||
+		isAWSErr(err, "RequestLimitExceeded", "") ||
+		isAWSErr(err, "ScalingActivityInProgress", "")
+}
+
+// autoscalingPolicyMissingOnDelete reports whether err is the ValidationError
+// DeletePolicy/DescribePolicies return once the policy's AutoScaling group,
+// and therefore the policy itself, no longer exists.
+func autoscalingPolicyMissingOnDelete(err error) bool {
+	return isAWSErr(err, "ValidationError", "not found")
+}
+
+// autoscalingPolicyRetry calls f, retrying with full-jitter exponential
+// backoff (base 500ms, capped at 30s) while autoscalingPolicyRetryable(err)
+// is true, until timeout elapses. The last error is returned verbatim on
+// timeout so callers see the real AWS error rather than a generic one.
+func autoscalingPolicyRetry(timeout time.Duration, f func() error) error {
+	deadline := time.Now().Add(timeout)
+
+	for attempt := 0; ; attempt++ {
+		err := f()
+		if err == nil || !autoscalingPolicyRetryable(err) {
+			return err
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return err
+		}
+
+		delay := autoscalingPolicyRetryBaseDelay * time.Duration(int64(1)<<uint(attempt))
+		if delay > autoscalingPolicyRetryMaxDelay {
+			delay = autoscalingPolicyRetryMaxDelay
+		}
+		delay = time.Duration(rand.Int63n(int64(delay) + 1)) // full jitter
+		if delay > remaining {
+			delay = remaining
+		}
+
+		time.Sleep(delay)
+	}
+}
@@ -2,17 +2,73 @@ package aws
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func TestAutoscalingPolicyRetry(t *testing.T) {
+	throttled := awserr.New("Throttling", "rate exceeded", nil)
+
+	t.Run("retries until success", func(t *testing.T) {
+		attempts := 0
+		err := autoscalingPolicyRetry(time.Minute, func() error {
+			attempts++
+			if attempts < 3 {
+				return throttled
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("expected success, got %s", err)
+		}
+		if attempts != 3 {
+			t.Fatalf("expected 3 attempts, got %d", attempts)
+		}
+	})
+
+	t.Run("gives up once the timeout elapses and surfaces the last error", func(t *testing.T) {
+		attempts := 0
+		err := autoscalingPolicyRetry(50*time.Millisecond, func() error {
+			attempts++
+			return throttled
+		})
+		if err == nil {
+			t.Fatal("expected an error once the timeout elapsed")
+		}
+		if err.(awserr.Error).Code() != "Throttling" {
+			t.Fatalf("expected the last AWS error to be surfaced verbatim, got %s", err)
+		}
+		if attempts < 2 {
+			t.Fatalf("expected more than one attempt before the timeout, got %d", attempts)
+		}
+	})
+
+	t.Run("does not retry non-retryable errors", func(t *testing.T) {
+		nonRetryable := awserr.New("ValidationError", "bogus", nil)
+		attempts := 0
+		err := autoscalingPolicyRetry(time.Minute, func() error {
+			attempts++
+			return nonRetryable
+		})
+		if err != nonRetryable {
+			t.Fatalf("expected the non-retryable error back immediately, got %s", err)
+		}
+		if attempts != 1 {
+			t.Fatalf("expected exactly 1 attempt, got %d", attempts)
+		}
+	})
+}
+
 func TestAccAWSAutoscalingPolicy_basic(t *testing.T) {
 	var policy autoscaling.ScalingPolicy
 
@@ -250,6 +306,188 @@ func TestAccAWSAutoscalingPolicy_TargetTrack_Custom(t *testing.T) {
 	})
 }
 
+func TestAccAWSAutoscalingPolicy_Alarm(t *testing.T) {
+	var policy autoscaling.ScalingPolicy
+
+	resourceName := "aws_autoscaling_policy.foobar_simple"
+	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, autoscaling.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAutoscalingPolicyAlarmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAutoscalingPolicyConfig_alarm(name, 80),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalingPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "alarm.#", "1"),
+					testAccCheckAWSAutoscalingPolicyAlarmExists(name+"-high-cpu"),
+				),
+			},
+			{
+				Config: testAccAWSAutoscalingPolicyConfig_alarm(name, 90),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalingPolicyExists(resourceName, &policy),
+					testAccCheckAWSAutoscalingPolicyAlarmExists(name+"-high-cpu"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAutoscalingPolicyImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAutoscalingPolicyAlarmExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn
+
+		resp, err := conn.DescribeAlarms(&cloudwatch.DescribeAlarmsInput{
+			AlarmNames: aws.StringSlice([]string{name}),
+		})
+		if err != nil {
+			return err
+		}
+		if len(resp.MetricAlarms) == 0 {
+			return fmt.Errorf("CloudWatch alarm %q not found", name)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSAutoscalingPolicyAlarmDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_autoscaling_policy" {
+			continue
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["alarm.#"])
+		if err != nil || count == 0 {
+			continue
+		}
+
+		for key, name := range rs.Primary.Attributes {
+			if !strings.HasSuffix(key, ".name") || !strings.HasPrefix(key, "alarm.") {
+				continue
+			}
+
+			resp, err := conn.DescribeAlarms(&cloudwatch.DescribeAlarmsInput{
+				AlarmNames: aws.StringSlice([]string{name}),
+			})
+			if err != nil {
+				return err
+			}
+			if len(resp.MetricAlarms) != 0 {
+				return fmt.Errorf("CloudWatch alarm %q still exists", name)
+			}
+		}
+	}
+
+	return testAccCheckAWSAutoscalingPolicyDestroy(s)
+}
+
+func TestAccAWSAutoscalingPolicy_TargetTrack_CustomMetricMath(t *testing.T) {
+	var policy autoscaling.ScalingPolicy
+
+	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, autoscaling.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAutoscalingPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAutoscalingPolicyConfig_TargetTracking_CustomMetricMath(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalingPolicyExists("aws_autoscaling_policy.test", &policy),
+					resource.TestCheckResourceAttr("aws_autoscaling_policy.test", "target_tracking_configuration.0.customized_metric_specification.0.metrics.#", "3"),
+					resource.TestCheckResourceAttr("aws_autoscaling_policy.test", "target_tracking_configuration.0.customized_metric_specification.0.metric_name", ""),
+				),
+			},
+			{
+				ResourceName:      "aws_autoscaling_policy.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAutoscalingPolicyImportStateIdFunc("aws_autoscaling_policy.test"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAutoscalingPolicy_PredictiveScaling_Predefined(t *testing.T) {
+	var policy autoscaling.ScalingPolicy
+
+	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, autoscaling.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAutoscalingPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAutoscalingPolicyConfig_PredictiveScaling_Predefined(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalingPolicyExists("aws_autoscaling_policy.test", &policy),
+					resource.TestCheckResourceAttr("aws_autoscaling_policy.test", "policy_type", "PredictiveScaling"),
+					resource.TestCheckResourceAttr("aws_autoscaling_policy.test", "predictive_scaling_configuration.#", "1"),
+					resource.TestCheckResourceAttr("aws_autoscaling_policy.test", "predictive_scaling_configuration.0.mode", "ForecastAndScale"),
+					resource.TestCheckResourceAttr("aws_autoscaling_policy.test", "predictive_scaling_configuration.0.max_capacity_breach_behavior", "HonorMaxCapacity"),
+					resource.TestCheckResourceAttr("aws_autoscaling_policy.test", "predictive_scaling_configuration.0.metric_specification.#", "1"),
+					resource.TestCheckResourceAttr("aws_autoscaling_policy.test", "predictive_scaling_configuration.0.metric_specification.0.predefined_metric_pair_specification.0.predefined_metric_type", "ASGCPUUtilization"),
+					resource.TestCheckResourceAttr("aws_autoscaling_policy.test", "predictive_scaling_configuration.0.metric_specification.0.target_value", "40"),
+				),
+			},
+			{
+				ResourceName:      "aws_autoscaling_policy.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAutoscalingPolicyImportStateIdFunc("aws_autoscaling_policy.test"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAutoscalingPolicy_PredictiveScaling_Custom(t *testing.T) {
+	var policy autoscaling.ScalingPolicy
+
+	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, autoscaling.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAutoscalingPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAutoscalingPolicyConfig_PredictiveScaling_Custom(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalingPolicyExists("aws_autoscaling_policy.test", &policy),
+					resource.TestCheckResourceAttr("aws_autoscaling_policy.test", "policy_type", "PredictiveScaling"),
+					resource.TestCheckResourceAttr("aws_autoscaling_policy.test", "predictive_scaling_configuration.0.mode", "ForecastOnly"),
+					resource.TestCheckResourceAttr("aws_autoscaling_policy.test", "predictive_scaling_configuration.0.metric_specification.0.customized_load_metric_specification.0.metric_data_queries.#", "1"),
+					resource.TestCheckResourceAttr("aws_autoscaling_policy.test", "predictive_scaling_configuration.0.metric_specification.0.customized_load_metric_specification.0.metric_data_queries.0.return_data", "true"),
+				),
+			},
+			{
+				ResourceName:      "aws_autoscaling_policy.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAutoscalingPolicyImportStateIdFunc("aws_autoscaling_policy.test"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSAutoscalingPolicy_zerovalue(t *testing.T) {
 	var simplepolicy autoscaling.ScalingPolicy
 	var steppolicy autoscaling.ScalingPolicy
@@ -566,3 +804,183 @@ resource "aws_autoscaling_policy" "foobar_step" {
 }
 `, name, name)
 }
+
+func testAccAWSAutoscalingPolicyConfig_alarm(name string, threshold int) string {
+	return testAccAWSAutoscalingPolicyConfig_base(name) + fmt.Sprintf(`
+resource "aws_autoscaling_policy" "foobar_simple" {
+  name                   = "%s-foobar_simple"
+  adjustment_type        = "ChangeInCapacity"
+  cooldown               = 300
+  policy_type            = "SimpleScaling"
+  scaling_adjustment     = 2
+  autoscaling_group_name = aws_autoscaling_group.test.name
+
+  alarm {
+    name                = "%s-high-cpu"
+    comparison_operator = "GreaterThanThreshold"
+    evaluation_periods  = 2
+    metric_name         = "CPUUtilization"
+    namespace           = "AWS/EC2"
+    period              = 120
+    statistic           = "Average"
+    threshold           = %d
+  }
+}
+`, name, name, threshold)
+}
+
+func testAccAwsAutoscalingPolicyConfig_TargetTracking_CustomMetricMath(name string) string {
+	return testAccAWSAutoscalingPolicyConfig_base(name) + fmt.Sprintf(`
+resource "aws_autoscaling_policy" "test" {
+  name                   = "%s-test"
+  policy_type            = "TargetTrackingScaling"
+  autoscaling_group_name = aws_autoscaling_group.test.name
+
+  target_tracking_configuration {
+    customized_metric_specification {
+      metrics {
+        id         = "backlog_per_instance"
+        expression = "m1 / m2"
+        label      = "SQS backlog per instance"
+
+        return_data = true
+      }
+
+      metrics {
+        id          = "m1"
+        return_data = false
+
+        metric_stat {
+          metric {
+            namespace   = "AWS/SQS"
+            metric_name = "ApproximateNumberOfMessagesVisible"
+
+            dimensions {
+              name  = "QueueName"
+              value = "test"
+            }
+          }
+
+          stat = "Sum"
+        }
+      }
+
+      metrics {
+        id          = "m2"
+        return_data = false
+
+        metric_stat {
+          metric {
+            namespace   = "AWS/AutoScaling"
+            metric_name = "GroupInServiceInstances"
+
+            dimensions {
+              name  = "AutoScalingGroupName"
+              value = aws_autoscaling_group.test.name
+            }
+          }
+
+          stat = "Average"
+        }
+      }
+    }
+
+    target_value = 100.0
+  }
+}
+`, name)
+}
+
+func testAccAwsAutoscalingPolicyConfig_PredictiveScaling_Predefined(name string) string {
+	return testAccAWSAutoscalingPolicyConfig_base(name) + fmt.Sprintf(`
+resource "aws_autoscaling_policy" "test" {
+  name                   = "%s-test"
+  policy_type            = "PredictiveScaling"
+  autoscaling_group_name = aws_autoscaling_group.test.name
+
+  predictive_scaling_configuration {
+    mode                         = "ForecastAndScale"
+    max_capacity_breach_behavior = "HonorMaxCapacity"
+    scheduling_buffer_time       = 0
+
+    metric_specification {
+      target_value = 40.0
+
+      predefined_metric_pair_specification {
+        predefined_metric_type = "ASGCPUUtilization"
+      }
+    }
+  }
+}
+`, name)
+}
+
+func testAccAwsAutoscalingPolicyConfig_PredictiveScaling_Custom(name string) string {
+	return testAccAWSAutoscalingPolicyConfig_base(name) + fmt.Sprintf(`
+resource "aws_autoscaling_policy" "test" {
+  name                   = "%s-test"
+  policy_type            = "PredictiveScaling"
+  autoscaling_group_name = aws_autoscaling_group.test.name
+
+  predictive_scaling_configuration {
+    mode = "ForecastOnly"
+
+    metric_specification {
+      target_value = 40.0
+
+      customized_load_metric_specification {
+        metric_data_queries {
+          id          = "load_sum"
+          expression  = "SUM(SEARCH('{AWS/EC2,AutoScalingGroupName} MetricName=\"CPUUtilization\"', 'Sum', 300))"
+          return_data = true
+        }
+      }
+
+      customized_capacity_metric_specification {
+        metric_data_queries {
+          id = "capacity_sum"
+
+          metric_stat {
+            metric {
+              namespace   = "AWS/AutoScaling"
+              metric_name = "GroupInServiceInstances"
+
+              dimensions {
+                name  = "AutoScalingGroupName"
+                value = aws_autoscaling_group.test.name
+              }
+            }
+
+            stat = "Average"
+          }
+
+          return_data = false
+        }
+      }
+
+      customized_scaling_metric_specification {
+        metric_data_queries {
+          id = "scaling_sum"
+
+          metric_stat {
+            metric {
+              namespace   = "AWS/EC2"
+              metric_name = "CPUUtilization"
+
+              dimensions {
+                name  = "AutoScalingGroupName"
+                value = aws_autoscaling_group.test.name
+              }
+            }
+
+            stat = "Average"
+          }
+
+          return_data = true
+        }
+      }
+    }
+  }
+}
+`, name)
+}
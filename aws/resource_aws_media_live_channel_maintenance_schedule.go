@@ -0,0 +1,124 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// resourceAwsMediaLiveChannelMaintenanceSchedule does not manage a real
+// MediaLive object of its own -- it lets operators bump an existing
+// channel's one-off maintenance_scheduled_date without going through the
+// channel resource's full UpdateChannel diff (which would otherwise force
+// a re-apply of every other encoder_settings field just to reschedule
+// maintenance). Create and Update both resolve to "push the scheduled date,
+// then read the channel's maintenance status back"; Delete only drops the
+// resource from state, since there is no separate AWS-side object to tear
+// down -- the channel itself continues to own the maintenance window.
+func resourceAwsMediaLiveChannelMaintenanceSchedule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaLiveChannelMaintenanceScheduleCreate,
+		Read:   resourceAwsMediaLiveChannelMaintenanceScheduleRead,
+		Update: resourceAwsMediaLiveChannelMaintenanceScheduleUpdate,
+		Delete: resourceAwsMediaLiveChannelMaintenanceScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"channel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"maintenance_scheduled_date": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"maintenance_day": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"maintenance_start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsMediaLiveChannelMaintenanceScheduleCreate(d *schema.ResourceData, meta interface{}) error {
+	channelId := d.Get("channel_id").(string)
+	d.SetId(channelId)
+
+	if err := updateMediaLiveChannelMaintenanceScheduledDate(meta, channelId, d.Get("maintenance_scheduled_date").(string)); err != nil {
+		return err
+	}
+
+	return resourceAwsMediaLiveChannelMaintenanceScheduleRead(d, meta)
+}
+
+func resourceAwsMediaLiveChannelMaintenanceScheduleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	resp, err := conn.DescribeChannel(&medialive.DescribeChannelInput{
+		ChannelId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] MediaLive Channel %s not found, error code (404)", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error describing MediaLive Channel(%s): %s", d.Id(), err)
+	}
+
+	d.Set("channel_id", aws.StringValue(resp.Id))
+
+	maintenance := flattenChannelMaintenance(resp.Maintenance)
+	if len(maintenance) > 0 {
+		m := maintenance[0].(map[string]interface{})
+		d.Set("maintenance_day", m["maintenance_day"])
+		d.Set("maintenance_start_time", m["maintenance_start_time"])
+		d.Set("maintenance_scheduled_date", m["maintenance_scheduled_date"])
+	}
+
+	return nil
+}
+
+func resourceAwsMediaLiveChannelMaintenanceScheduleUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("maintenance_scheduled_date") {
+		if err := updateMediaLiveChannelMaintenanceScheduledDate(meta, d.Id(), d.Get("maintenance_scheduled_date").(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsMediaLiveChannelMaintenanceScheduleRead(d, meta)
+}
+
+func resourceAwsMediaLiveChannelMaintenanceScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func updateMediaLiveChannelMaintenanceScheduledDate(meta interface{}, channelId, scheduledDate string) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	_, err := conn.UpdateChannel(&medialive.UpdateChannelInput{
+		ChannelId: aws.String(channelId),
+		Maintenance: &medialive.MaintenanceUpdateSettings{
+			MaintenanceScheduledDate: aws.String(scheduledDate),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating MediaLive Channel(%s) maintenance schedule: %s", channelId, err)
+	}
+	return nil
+}
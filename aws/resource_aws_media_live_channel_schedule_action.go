@@ -0,0 +1,644 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// resourceAwsMediaLiveChannelScheduleAction manages a single entry in a
+// channel's schedule via MediaLive's BatchUpdateSchedule API. Schedule
+// actions have no Update API of their own -- changing one means deleting
+// it and creating a replacement, so every field below is ForceNew.
+func resourceAwsMediaLiveChannelScheduleAction() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaLiveChannelScheduleActionCreate,
+		Read:   resourceAwsMediaLiveChannelScheduleActionRead,
+		Delete: resourceAwsMediaLiveChannelScheduleActionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"channel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"action_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Exactly one of the fixed/follow/immediate start settings must be
+			// configured; resourceAwsMediaLiveChannelScheduleActionCreate
+			// expands each one that is set and does not check this.
+			"schedule_action_start_settings": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fixed_mode_schedule_action_start_settings": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"time": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+
+						"follow_mode_schedule_action_start_settings": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"follow_point": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											medialive.FollowPointEnd,
+											medialive.FollowPointStart,
+										}, false),
+									},
+
+									"reference_action_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+
+						"immediate_mode_schedule_action_start_settings": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			// Exactly one of the settings blocks below must be configured; see
+			// resourceAwsMediaLiveChannelScheduleActionCreate.
+			"scte35_splice_insert_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"splice_event_id": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"duration": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"no_regional_blackout_flag": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"web_delivery_allowed_flag": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"scte35_time_signal_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"segmentation_event_id": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"segmentation_cancel_indicator": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"segmentation_duration": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"segmentation_type_id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"segmentation_upid": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"segmentation_upid_type": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"scte35_return_to_network_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"splice_event_id": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"hls_id3_segment_tagging_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tag": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"hls_timed_metadata_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id3": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"input_switch_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"input_attachment_name_reference": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"url_path": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"pause_state_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pipelines": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"static_image_activate_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"image_uri": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"duration": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"fade_in": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"fade_out": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"height": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"width": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"image_x": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"image_y": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"layer": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"opacity": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"static_image_deactivate_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fade_out": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"layer": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsMediaLiveChannelScheduleActionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	channelId := d.Get("channel_id").(string)
+	actionName := d.Get("action_name").(string)
+
+	settings, err := expandMediaLiveScheduleActionSettings(d)
+	if err != nil {
+		return err
+	}
+
+	action := &medialive.ScheduleAction{
+		ActionName:                  aws.String(actionName),
+		ScheduleActionStartSettings: expandMediaLiveScheduleActionStartSettings(d.Get("schedule_action_start_settings").(*schema.Set)),
+		ScheduleActionSettings:      settings,
+	}
+
+	_, err = conn.BatchUpdateSchedule(&medialive.BatchUpdateScheduleInput{
+		ChannelId: aws.String(channelId),
+		Creates: &medialive.BatchScheduleActionCreateRequest{
+			ScheduleActions: []*medialive.ScheduleAction{action},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating MediaLive Channel Schedule Action: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", channelId, actionName))
+
+	return resourceAwsMediaLiveChannelScheduleActionRead(d, meta)
+}
+
+func resourceAwsMediaLiveChannelScheduleActionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	channelId := d.Get("channel_id").(string)
+	actionName := d.Get("action_name").(string)
+
+	action, err := findMediaLiveScheduleAction(conn, channelId, actionName)
+	if err != nil {
+		return fmt.Errorf("error describing MediaLive Channel (%s) Schedule: %s", channelId, err)
+	}
+
+	if action == nil {
+		log.Printf("[WARN] MediaLive Channel (%s) Schedule Action (%s) not found", channelId, actionName)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("channel_id", channelId)
+	d.Set("action_name", aws.StringValue(action.ActionName))
+
+	return nil
+}
+
+func resourceAwsMediaLiveChannelScheduleActionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	channelId := d.Get("channel_id").(string)
+	actionName := d.Get("action_name").(string)
+
+	_, err := conn.BatchUpdateSchedule(&medialive.BatchUpdateScheduleInput{
+		ChannelId: aws.String(channelId),
+		Deletes: &medialive.BatchScheduleActionDeleteRequest{
+			ActionNames: []*string{aws.String(actionName)},
+		},
+	})
+	if err != nil {
+		if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting MediaLive Channel Schedule Action (%s/%s): %s", channelId, actionName, err)
+	}
+
+	return nil
+}
+
+// findMediaLiveScheduleAction paginates DescribeSchedule looking for an
+// action name match, as BatchUpdateSchedule has no single-action describe.
+func findMediaLiveScheduleAction(conn *medialive.MediaLive, channelId, actionName string) (*medialive.ScheduleAction, error) {
+	var found *medialive.ScheduleAction
+
+	err := conn.DescribeSchedulePages(&medialive.DescribeScheduleInput{ChannelId: aws.String(channelId)}, func(page *medialive.DescribeScheduleOutput, lastPage bool) bool {
+		for _, action := range page.ScheduleActions {
+			if aws.StringValue(action.ActionName) == actionName {
+				found = action
+				return false
+			}
+		}
+		return !lastPage
+	})
+	if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return found, nil
+}
+
+func expandMediaLiveScheduleActionStartSettings(s *schema.Set) *medialive.ScheduleActionStartSettings {
+	if s.Len() == 0 {
+		return nil
+	}
+	settings := s.List()[0].(map[string]interface{})
+	result := &medialive.ScheduleActionStartSettings{}
+
+	if v := settings["fixed_mode_schedule_action_start_settings"].(*schema.Set); v.Len() > 0 {
+		raw := v.List()[0].(map[string]interface{})
+		result.FixedModeScheduleActionStartSettings = &medialive.FixedModeScheduleActionStartSettings{
+			Time: aws.String(raw["time"].(string)),
+		}
+	}
+
+	if v := settings["follow_mode_schedule_action_start_settings"].(*schema.Set); v.Len() > 0 {
+		raw := v.List()[0].(map[string]interface{})
+		result.FollowModeScheduleActionStartSettings = &medialive.FollowModeScheduleActionStartSettings{
+			FollowPoint:         aws.String(raw["follow_point"].(string)),
+			ReferenceActionName: aws.String(raw["reference_action_name"].(string)),
+		}
+	}
+
+	if v := settings["immediate_mode_schedule_action_start_settings"].(bool); v {
+		result.ImmediateModeScheduleActionStartSettings = &medialive.ImmediateModeScheduleActionStartSettings{}
+	}
+
+	return result
+}
+
+// expandMediaLiveScheduleActionSettings dispatches to exactly one of the
+// ScheduleActionSettings variants, mirroring the "exactly one variant
+// configured" convention used by the channel's output_group_settings (see
+// resourceAwsMediaLiveChannelCustomizeDiff in resource_aws_media_live_channel.go).
+func expandMediaLiveScheduleActionSettings(d *schema.ResourceData) (*medialive.ScheduleActionSettings, error) {
+	result := &medialive.ScheduleActionSettings{}
+	var configured []string
+
+	if v := d.Get("scte35_splice_insert_settings").(*schema.Set); v.Len() > 0 {
+		configured = append(configured, "scte35_splice_insert_settings")
+		raw := v.List()[0].(map[string]interface{})
+		settings := &medialive.Scte35SpliceInsertScheduleActionSettings{
+			SpliceEventId: aws.Int64(int64(raw["splice_event_id"].(int))),
+		}
+		if dur := raw["duration"].(int); dur > 0 {
+			settings.Duration = aws.Int64(int64(dur))
+		}
+		if v := raw["no_regional_blackout_flag"].(string); v != "" {
+			settings.NoRegionalBlackoutFlag = aws.String(v)
+		}
+		if v := raw["web_delivery_allowed_flag"].(string); v != "" {
+			settings.WebDeliveryAllowedFlag = aws.String(v)
+		}
+		result.Scte35SpliceInsertSettings = settings
+	}
+
+	if v := d.Get("scte35_time_signal_settings").(*schema.Set); v.Len() > 0 {
+		configured = append(configured, "scte35_time_signal_settings")
+		raw := v.List()[0].(map[string]interface{})
+		segmentationDescriptor := &medialive.Scte35SegmentationDescriptor{
+			SegmentationEventId:         aws.Int64(int64(raw["segmentation_event_id"].(int))),
+			SegmentationCancelIndicator: aws.String(raw["segmentation_cancel_indicator"].(string)),
+		}
+		if v := raw["segmentation_duration"].(int); v > 0 {
+			segmentationDescriptor.SegmentationDuration = aws.Int64(int64(v))
+		}
+		if v := raw["segmentation_type_id"].(int); v > 0 {
+			segmentationDescriptor.SegmentationTypeId = aws.Int64(int64(v))
+		}
+		if v := raw["segmentation_upid"].(string); v != "" {
+			segmentationDescriptor.SegmentationUpid = aws.String(v)
+		}
+		if v := raw["segmentation_upid_type"].(int); v > 0 {
+			segmentationDescriptor.SegmentationUpidType = aws.Int64(int64(v))
+		}
+		result.Scte35TimeSignalSettings = &medialive.Scte35TimeSignalScheduleActionSettings{
+			Scte35Descriptors: []*medialive.Scte35Descriptor{
+				{
+					Scte35DescriptorSettings: &medialive.Scte35DescriptorSettings{
+						SegmentationDescriptorScte35DescriptorSettings: segmentationDescriptor,
+					},
+				},
+			},
+		}
+	}
+
+	if v := d.Get("scte35_return_to_network_settings").(*schema.Set); v.Len() > 0 {
+		configured = append(configured, "scte35_return_to_network_settings")
+		raw := v.List()[0].(map[string]interface{})
+		result.Scte35ReturnToNetworkSettings = &medialive.Scte35ReturnToNetworkScheduleActionSettings{
+			SpliceEventId: aws.Int64(int64(raw["splice_event_id"].(int))),
+		}
+	}
+
+	if v := d.Get("hls_id3_segment_tagging_settings").(*schema.Set); v.Len() > 0 {
+		configured = append(configured, "hls_id3_segment_tagging_settings")
+		raw := v.List()[0].(map[string]interface{})
+		result.HlsId3SegmentTaggingSettings = &medialive.HlsId3SegmentTaggingScheduleActionSettings{
+			Tag: aws.String(raw["tag"].(string)),
+		}
+	}
+
+	if v := d.Get("hls_timed_metadata_settings").(*schema.Set); v.Len() > 0 {
+		configured = append(configured, "hls_timed_metadata_settings")
+		raw := v.List()[0].(map[string]interface{})
+		result.HlsTimedMetadataSettings = &medialive.HlsTimedMetadataScheduleActionSettings{
+			Id3: aws.String(raw["id3"].(string)),
+		}
+	}
+
+	if v := d.Get("input_switch_settings").(*schema.Set); v.Len() > 0 {
+		configured = append(configured, "input_switch_settings")
+		raw := v.List()[0].(map[string]interface{})
+		settings := &medialive.InputSwitchScheduleActionSettings{
+			InputAttachmentNameReference: aws.String(raw["input_attachment_name_reference"].(string)),
+		}
+		if v := raw["url_path"].([]interface{}); len(v) > 0 {
+			settings.UrlPath = expandStringList(v)
+		}
+		result.InputSwitchSettings = settings
+	}
+
+	if v := d.Get("pause_state_settings").(*schema.Set); v.Len() > 0 {
+		configured = append(configured, "pause_state_settings")
+		raw := v.List()[0].(map[string]interface{})
+		var pipelines []*medialive.PipelinePauseStateSettings
+		for _, id := range raw["pipelines"].([]interface{}) {
+			pipelines = append(pipelines, &medialive.PipelinePauseStateSettings{
+				PipelineId: aws.String(id.(string)),
+			})
+		}
+		result.PauseStateSettings = &medialive.PauseStateScheduleActionSettings{
+			Pipelines: pipelines,
+		}
+	}
+
+	if v := d.Get("static_image_activate_settings").(*schema.Set); v.Len() > 0 {
+		configured = append(configured, "static_image_activate_settings")
+		raw := v.List()[0].(map[string]interface{})
+		settings := &medialive.StaticImageActivateScheduleActionSettings{
+			Image: &medialive.InputLocation{Uri: aws.String(raw["image_uri"].(string))},
+		}
+		if v := raw["duration"].(int); v > 0 {
+			settings.Duration = aws.Int64(int64(v))
+		}
+		if v := raw["fade_in"].(int); v > 0 {
+			settings.FadeIn = aws.Int64(int64(v))
+		}
+		if v := raw["fade_out"].(int); v > 0 {
+			settings.FadeOut = aws.Int64(int64(v))
+		}
+		if v := raw["height"].(int); v > 0 {
+			settings.Height = aws.Int64(int64(v))
+		}
+		if v := raw["width"].(int); v > 0 {
+			settings.Width = aws.Int64(int64(v))
+		}
+		if v := raw["image_x"].(int); v > 0 {
+			settings.ImageX = aws.Int64(int64(v))
+		}
+		if v := raw["image_y"].(int); v > 0 {
+			settings.ImageY = aws.Int64(int64(v))
+		}
+		if v := raw["layer"].(int); v > 0 {
+			settings.Layer = aws.Int64(int64(v))
+		}
+		if v := raw["opacity"].(int); v > 0 {
+			settings.Opacity = aws.Int64(int64(v))
+		}
+		result.StaticImageActivateSettings = settings
+	}
+
+	if v := d.Get("static_image_deactivate_settings").(*schema.Set); v.Len() > 0 {
+		configured = append(configured, "static_image_deactivate_settings")
+		raw := v.List()[0].(map[string]interface{})
+		settings := &medialive.StaticImageDeactivateScheduleActionSettings{}
+		if v := raw["fade_out"].(int); v > 0 {
+			settings.FadeOut = aws.Int64(int64(v))
+		}
+		if v := raw["layer"].(int); v > 0 {
+			settings.Layer = aws.Int64(int64(v))
+		}
+		result.StaticImageDeactivateSettings = settings
+	}
+
+	if len(configured) != 1 {
+		return nil, fmt.Errorf("exactly one schedule action settings block must be configured, got %d: %s", len(configured), configured)
+	}
+
+	return result, nil
+}
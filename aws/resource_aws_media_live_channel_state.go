@@ -0,0 +1,122 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// resourceAwsMediaLiveChannelState manages the run state of an existing
+// Channel out-of-band from resourceAwsMediaLiveChannel's own desired_state
+// argument, the same separation terraform-provider-aws draws between e.g.
+// aws_instance and aws_ec2_instance_state: it lets operators pin/flip a
+// channel's RUNNING/IDLE state without forcing every consumer of the
+// channel resource to also own its lifecycle. Deleting this resource only
+// stops managing state; it does not stop or start the channel.
+func resourceAwsMediaLiveChannelState() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaLiveChannelStateCreate,
+		Read:   resourceAwsMediaLiveChannelStateRead,
+		Update: resourceAwsMediaLiveChannelStateUpdate,
+		Delete: resourceAwsMediaLiveChannelStateDelete,
+
+		Schema: map[string]*schema.Schema{
+			"channel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					medialive.ChannelStateRunning,
+					medialive.ChannelStateIdle,
+				}, false),
+			},
+
+			"start_timeout": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"stop_timeout": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAwsMediaLiveChannelStateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	channelId := d.Get("channel_id").(string)
+	d.SetId(channelId)
+
+	unlock := lockMediaLiveChannelTransition(channelId)
+	defer unlock()
+
+	if err := setMediaLiveChannelState(conn, d); err != nil {
+		return err
+	}
+
+	return resourceAwsMediaLiveChannelStateRead(d, meta)
+}
+
+func resourceAwsMediaLiveChannelStateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	resp, err := conn.DescribeChannel(&medialive.DescribeChannelInput{ChannelId: aws.String(d.Id())})
+	if err != nil {
+		if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] MediaLive Channel %s not found, error code (404)", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error describing MediaLive Channel(%s): %s", d.Id(), err)
+	}
+
+	d.Set("channel_id", d.Id())
+	d.Set("state", aws.StringValue(resp.State))
+
+	return nil
+}
+
+func resourceAwsMediaLiveChannelStateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	if d.HasChange("state") {
+		unlock := lockMediaLiveChannelTransition(d.Id())
+		defer unlock()
+
+		if err := setMediaLiveChannelState(conn, d); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsMediaLiveChannelStateRead(d, meta)
+}
+
+func resourceAwsMediaLiveChannelStateDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+// setMediaLiveChannelState issues StartChannel/StopChannel to drive the
+// channel towards d.Get("state"), reusing the waiters and transient
+// CHANNEL_STATE_CHANGED/resource-in-use tolerance that
+// resourceAwsMediaLiveChannel's own desired_state handling already relies
+// on via startMediaLiveChannel/stopMediaLiveChannel.
+func setMediaLiveChannelState(conn *medialive.MediaLive, d *schema.ResourceData) error {
+	channelId := d.Id()
+
+	if d.Get("state").(string) == medialive.ChannelStateRunning {
+		return startMediaLiveChannel(conn, channelId, mediaLiveChannelStartTimeout(d))
+	}
+	return stopMediaLiveChannel(conn, channelId, mediaLiveChannelStopTimeout(d))
+}
@@ -4,14 +4,14 @@ import (
 	"fmt"
 	"log"
 	"net/url"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/medialive"
 	"github.com/google/uuid"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/medialive/waiter"
 )
 
 func resourceAwsMediaLiveInput() *schema.Resource {
@@ -23,6 +23,12 @@ func resourceAwsMediaLiveInput() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.InputCreateTimeout),
+			Delete: schema.DefaultTimeout(waiter.InputDeleteTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -31,7 +37,7 @@ func resourceAwsMediaLiveInput() *schema.Resource {
 
 			"destinations": {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"ip": {
@@ -57,10 +63,98 @@ func resourceAwsMediaLiveInput() *schema.Resource {
 				},
 			},
 
+			// Sources are used by URL_PULL and RTMP_PULL inputs. Passwords are
+			// referenced via an SSM Parameter Store parameter name, not supplied
+			// directly, per the medialive API contract.
+			"sources": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"password_param": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"url": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"media_connect_flows": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"flow_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+
+			"vpc": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_ids": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"security_group_ids": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"input_devices": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
 			"input_type": {
 				Type:     schema.TypeString,
 				ForceNew: true,
 				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					medialive.InputTypeUdpPush,
+					medialive.InputTypeRtpPush,
+					medialive.InputTypeRtmpPush,
+					medialive.InputTypeRtmpPull,
+					medialive.InputTypeUrlPull,
+					medialive.InputTypeMp4File,
+					medialive.InputTypeMediaconnect,
+					medialive.InputTypeInputDevice,
+					medialive.InputTypeAwsCdi,
+				}, false),
 			},
 
 			"name": {
@@ -76,7 +170,7 @@ func resourceAwsMediaLiveInput() *schema.Resource {
 
 			"input_security_groups": {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
@@ -105,6 +199,22 @@ func resourceAwsMediaLiveInputCreate(d *schema.ResourceData, meta interface{}) e
 		)
 	}
 
+	if v, ok := d.GetOk("sources"); ok && len(v.([]interface{})) > 0 {
+		input.Sources = expandInputSources(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("media_connect_flows"); ok && len(v.([]interface{})) > 0 {
+		input.MediaConnectFlows = expandMediaConnectFlowRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("vpc"); ok && len(v.([]interface{})) > 0 {
+		input.Vpc = expandInputVpcRequest(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("input_devices"); ok && len(v.([]interface{})) > 0 {
+		input.InputDevices = expandInputDeviceSettings(v.([]interface{}))
+	}
+
 	if raw, ok := d.GetOk("input_security_groups"); ok {
 		input.InputSecurityGroups = convertInputSecurityGroups(raw)
 	}
@@ -120,27 +230,7 @@ func resourceAwsMediaLiveInputCreate(d *schema.ResourceData, meta interface{}) e
 
 	d.SetId(aws.StringValue(resp.Input.Id))
 
-	createStateConf := &resource.StateChangeConf{
-		Pending: []string{"CREATING"},
-		Target:  []string{"DETACHED", "ATTACHED"},
-		Refresh: func() (interface{}, string, error) {
-			input := &medialive.DescribeInputInput{
-				InputId: aws.String(d.Id()),
-			}
-			resp, err := conn.DescribeInput(input)
-			if err != nil {
-				return 0, "", err
-			}
-			return resp, aws.StringValue(resp.State), nil
-		},
-		Timeout:                   d.Timeout(schema.TimeoutCreate),
-		Delay:                     10 * time.Second,
-		MinTimeout:                5 * time.Second,
-		ContinuousTargetOccurence: 5,
-	}
-	_, err = createStateConf.WaitForState()
-
-	if err != nil {
+	if _, err := waiter.InputCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return fmt.Errorf("Error waiting MediaLive Input (%s) to be created: %s", d.Id(), err)
 	}
 
@@ -168,6 +258,22 @@ func resourceAwsMediaLiveInputRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("error setting destinations: %s", err)
 	}
 
+	if err := d.Set("sources", flattenInputSources(resp.Sources)); err != nil {
+		return fmt.Errorf("error setting sources: %s", err)
+	}
+
+	if err := d.Set("media_connect_flows", flattenMediaConnectFlows(resp.MediaConnectFlows)); err != nil {
+		return fmt.Errorf("error setting media_connect_flows: %s", err)
+	}
+
+	if err := d.Set("input_devices", flattenInputDeviceSettings(resp.InputDevices)); err != nil {
+		return fmt.Errorf("error setting input_devices: %s", err)
+	}
+
+	if err := d.Set("vpc", flattenInputVpcResponse(d.Get("vpc").([]interface{}), resp.SecurityGroups)); err != nil {
+		return fmt.Errorf("error setting vpc: %s", err)
+	}
+
 	d.Set("arn", aws.StringValue(resp.Arn))
 	d.Set("type", aws.StringValue(resp.Type))
 	d.Set("name", aws.StringValue(resp.Name))
@@ -191,7 +297,7 @@ func resourceAwsMediaLiveInputUpdate(d *schema.ResourceData, meta interface{}) e
 		input.Name = aws.String(d.Get("name").(string))
 	}
 
-	if d.HasChange("stream_name") {
+	if d.HasChange("destinations") {
 		if v, ok := d.GetOk("destinations"); ok && len(v.([]interface{})) > 0 {
 			input.Destinations = expandInputDestinations(
 				v.([]interface{}),
@@ -199,6 +305,24 @@ func resourceAwsMediaLiveInputUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChange("sources") {
+		if v, ok := d.GetOk("sources"); ok && len(v.([]interface{})) > 0 {
+			input.Sources = expandInputSources(v.([]interface{}))
+		}
+	}
+
+	if d.HasChange("media_connect_flows") {
+		if v, ok := d.GetOk("media_connect_flows"); ok && len(v.([]interface{})) > 0 {
+			input.MediaConnectFlows = expandMediaConnectFlowRequests(v.([]interface{}))
+		}
+	}
+
+	if d.HasChange("input_devices") {
+		if v, ok := d.GetOk("input_devices"); ok && len(v.([]interface{})) > 0 {
+			input.InputDevices = expandInputDeviceSettings(v.([]interface{}))
+		}
+	}
+
 	if d.HasChange("input_security_groups") {
 		if raw, ok := d.GetOk("input_security_groups"); ok {
 			input.InputSecurityGroups = convertInputSecurityGroups(raw)
@@ -239,65 +363,21 @@ func resourceAwsMediaLiveInputDelete(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error deleting MediaLive Input(%s): %s", d.Id(), err)
 	}
 
-	if err := waitForMediaLiveInputDeletion(conn, d.Id()); err != nil {
+	log.Printf("[DEBUG] Waiting for Media Live Input (%s) deletion", d.Id())
+	if err := waiter.InputDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return fmt.Errorf("Error waiting for deleting MediaLive Input(%s): %s", d.Id(), err)
 	}
 
 	return nil
 }
 
-func mediaLiveInputRefreshFunc(conn *medialive.MediaLive, inputId string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		input, err := conn.DescribeInput(&medialive.DescribeInputInput{
-			InputId: aws.String(inputId),
-		})
-
-		if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
-			return nil, medialive.InputStateDeleted, nil
-		}
-
-		if err != nil {
-			return nil, "", fmt.Errorf("error reading MediaLive Input(%s): %s", inputId, err)
-		}
-
-		if input == nil {
-			return nil, medialive.InputStateDeleted, nil
-		}
-
-		return input, aws.StringValue(input.State), nil
-	}
-}
-
-func waitForMediaLiveInputDeletion(conn *medialive.MediaLive, inputId string) error {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{
-			medialive.InputStateDetached,
-			medialive.InputStateAttached,
-			medialive.InputStateDeleting,
-		},
-		Target:         []string{medialive.InputStateDeleted},
-		Refresh:        mediaLiveInputRefreshFunc(conn, inputId),
-		Timeout:        30 * time.Minute,
-		NotFoundChecks: 1,
-	}
-
-	log.Printf("[DEBUG] Waiting for Media Live Input (%s) deletion", inputId)
-	_, err := stateConf.WaitForState()
-
-	if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
-		return nil
-	}
-
-	return err
-}
-
 func flattenInputDestinations(inputDestinations []*medialive.InputDestination) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0, len(inputDestinations))
 	for _, destination := range inputDestinations {
 		r := map[string]interface{}{
 			"url":         aws.StringValue(destination.Url),
-			"port":        aws.StringValue(destination.Ip),
-			"ip":          aws.StringValue(destination.Port),
+			"ip":          aws.StringValue(destination.Ip),
+			"port":        aws.StringValue(destination.Port),
 			"stream_name": obtainStreamName(destination.Url),
 		}
 		result = append(result, r)
@@ -321,6 +401,116 @@ func expandInputDestinations(destinations []interface{}) []*medialive.InputDesti
 	return result
 }
 
+func expandInputSources(sources []interface{}) []*medialive.InputSourceRequest {
+	var result []*medialive.InputSourceRequest
+	if len(sources) == 0 {
+		return nil
+	}
+
+	for _, source := range sources {
+		r := source.(map[string]interface{})
+
+		result = append(result, &medialive.InputSourceRequest{
+			Username:      aws.String(r["username"].(string)),
+			PasswordParam: aws.String(r["password_param"].(string)),
+			Url:           aws.String(r["url"].(string)),
+		})
+	}
+	return result
+}
+
+func flattenInputSources(sources []*medialive.InputSource) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(sources))
+	for _, source := range sources {
+		result = append(result, map[string]interface{}{
+			"username": aws.StringValue(source.Username),
+			"url":      aws.StringValue(source.Url),
+		})
+	}
+	return result
+}
+
+func expandMediaConnectFlowRequests(flows []interface{}) []*medialive.MediaConnectFlowRequest {
+	var result []*medialive.MediaConnectFlowRequest
+	if len(flows) == 0 {
+		return nil
+	}
+
+	for _, flow := range flows {
+		r := flow.(map[string]interface{})
+
+		result = append(result, &medialive.MediaConnectFlowRequest{
+			FlowArn: aws.String(r["flow_arn"].(string)),
+		})
+	}
+	return result
+}
+
+func flattenMediaConnectFlows(flows []*medialive.MediaConnectFlow) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(flows))
+	for _, flow := range flows {
+		result = append(result, map[string]interface{}{
+			"flow_arn": aws.StringValue(flow.FlowArn),
+		})
+	}
+	return result
+}
+
+func expandInputDeviceSettings(devices []interface{}) []*medialive.InputDeviceSettings {
+	var result []*medialive.InputDeviceSettings
+	if len(devices) == 0 {
+		return nil
+	}
+
+	for _, device := range devices {
+		r := device.(map[string]interface{})
+
+		result = append(result, &medialive.InputDeviceSettings{
+			Id: aws.String(r["id"].(string)),
+		})
+	}
+	return result
+}
+
+func flattenInputDeviceSettings(devices []*medialive.InputDeviceSettings) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(devices))
+	for _, device := range devices {
+		result = append(result, map[string]interface{}{
+			"id": aws.StringValue(device.Id),
+		})
+	}
+	return result
+}
+
+func expandInputVpcRequest(tfList []interface{}) *medialive.InputVpcRequest {
+	if len(tfList) == 0 {
+		return nil
+	}
+	m := tfList[0].(map[string]interface{})
+
+	return &medialive.InputVpcRequest{
+		SubnetIds:        expandStringList(m["subnet_ids"].([]interface{})),
+		SecurityGroupIds: expandStringList(m["security_group_ids"].([]interface{})),
+	}
+}
+
+// flattenInputVpcResponse preserves the subnet_ids configured at create time
+// (DescribeInput does not return them) while refreshing the security groups
+// actually attached to the input's VPC ENIs.
+func flattenInputVpcResponse(tfList []interface{}, securityGroups []*string) []interface{} {
+	if len(tfList) == 0 {
+		return nil
+	}
+	m := tfList[0].(map[string]interface{})
+
+	return []interface{}{
+		map[string]interface{}{
+			"subnet_ids":         m["subnet_ids"],
+			"security_group_ids": aws.StringValueSlice(securityGroups),
+		},
+	}
+}
+
 func convertInputSecurityGroups(raw interface{}) []*string {
 	list := raw.([]interface{})
 	inputSecurityGroups := make([]*string, len(list))
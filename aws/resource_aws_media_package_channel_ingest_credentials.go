@@ -0,0 +1,189 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediapackage"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// resourceAwsMediaPackageChannelIngestCredentials does not manage a real
+// MediaPackage object of its own -- it ties a rotation policy to an existing
+// channel's ingest endpoint credentials and surfaces the current
+// username/password pairs. Create and Update both resolve to "make sure the
+// channel's credentials are as fresh as rotation_trigger/rotate_on_schedule
+// require, then read them back"; Delete only drops the resource from state,
+// since there is no AWS-side object to tear down.
+func resourceAwsMediaPackageChannelIngestCredentials() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaPackageChannelIngestCredentialsCreate,
+		Read:   resourceAwsMediaPackageChannelIngestCredentialsRead,
+		Update: resourceAwsMediaPackageChannelIngestCredentialsUpdate,
+		Delete: resourceAwsMediaPackageChannelIngestCredentialsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"channel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"rotation_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"rotate_on_schedule": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"last_rotated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ingest_endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"password": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsMediaPackageChannelIngestCredentialsCreate(d *schema.ResourceData, meta interface{}) error {
+	channelId := d.Get("channel_id").(string)
+	d.SetId(channelId)
+
+	return resourceAwsMediaPackageChannelIngestCredentialsRead(d, meta)
+}
+
+func resourceAwsMediaPackageChannelIngestCredentialsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mediapackageconn
+
+	if rotateOnSchedule := d.Get("rotate_on_schedule").(string); rotateOnSchedule != "" {
+		due, err := mediaPackageCredentialsRotationDue(rotateOnSchedule, d.Get("last_rotated").(string))
+		if err != nil {
+			return err
+		}
+
+		if due {
+			if err := rotateMediaPackageChannelCredentials(conn, d.Id()); err != nil {
+				return err
+			}
+			d.Set("last_rotated", time.Now().UTC().Format(time.RFC3339))
+		}
+	}
+
+	resp, err := conn.DescribeChannel(&mediapackage.DescribeChannelInput{
+		Id: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, mediapackage.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] MediaPackage Channel %s not found, error code (404)", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error describing MediaPackage Channel(%s): %s", d.Id(), err)
+	}
+
+	d.Set("channel_id", aws.StringValue(resp.Id))
+
+	if resp.HlsIngest != nil {
+		d.Set("ingest_endpoints", flattenMediaPackageIngestEndpoints(resp.HlsIngest.IngestEndpoints))
+	}
+
+	return nil
+}
+
+func resourceAwsMediaPackageChannelIngestCredentialsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mediapackageconn
+
+	if d.HasChange("rotation_trigger") {
+		if err := rotateMediaPackageChannelCredentials(conn, d.Id()); err != nil {
+			return err
+		}
+		d.Set("last_rotated", time.Now().UTC().Format(time.RFC3339))
+	}
+
+	return resourceAwsMediaPackageChannelIngestCredentialsRead(d, meta)
+}
+
+func resourceAwsMediaPackageChannelIngestCredentialsDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func rotateMediaPackageChannelCredentials(conn *mediapackage.MediaPackage, channelId string) error {
+	_, err := conn.RotateChannelCredentials(&mediapackage.RotateChannelCredentialsInput{
+		Id: aws.String(channelId),
+	})
+	if err != nil {
+		return fmt.Errorf("Error rotating MediaPackage Channel(%s) ingest credentials: %s", channelId, err)
+	}
+	return nil
+}
+
+// mediaPackageCredentialsRotationDue reports whether rotate_on_schedule
+// (a Go duration string, e.g. "720h") has elapsed since lastRotated (an
+// RFC3339 timestamp). An empty lastRotated -- no rotation recorded yet --
+// counts as due.
+func mediaPackageCredentialsRotationDue(rotateOnSchedule, lastRotated string) (bool, error) {
+	interval, err := time.ParseDuration(rotateOnSchedule)
+	if err != nil {
+		return false, fmt.Errorf("Error parsing rotate_on_schedule(%s): %s", rotateOnSchedule, err)
+	}
+
+	if lastRotated == "" {
+		return true, nil
+	}
+
+	last, err := time.Parse(time.RFC3339, lastRotated)
+	if err != nil {
+		return false, fmt.Errorf("Error parsing last_rotated(%s): %s", lastRotated, err)
+	}
+
+	return time.Since(last) >= interval, nil
+}
+
+func flattenMediaPackageIngestEndpoints(endpoints []*mediapackage.IngestEndpoint) []interface{} {
+	out := make([]interface{}, 0, len(endpoints))
+	for _, e := range endpoints {
+		out = append(out, map[string]interface{}{
+			"id":       aws.StringValue(e.Id),
+			"url":      aws.StringValue(e.Url),
+			"username": aws.StringValue(e.Username),
+			"password": aws.StringValue(e.Password),
+		})
+	}
+	return out
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/mediapackage"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
@@ -80,8 +81,9 @@ func resourceAwsMediaPackageOriginEndpoint() *schema.Resource {
 			},
 
 			"hls_package": {
-				Type:     schema.TypeSet,
-				Optional: true,
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ExactlyOneOf: []string{"hls_package", "dash_package", "cmaf_package", "mss_package"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"segment_duration_seconds": {
@@ -156,6 +158,360 @@ func resourceAwsMediaPackageOriginEndpoint() *schema.Resource {
 				},
 			},
 
+			"dash_package": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ExactlyOneOf: []string{"hls_package", "dash_package", "cmaf_package", "mss_package"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"segment_duration_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+
+						"manifest_layout": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"min_buffer_time_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+
+						"min_update_period_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+
+						"suggested_presentation_delay_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+
+						"period_triggers": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"profile": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"segment_template_format": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"ad_triggers": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"ads_on_delivery_restrictions": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"stream_selection": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"stream_order": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"max_video_bits_per_second": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+
+									"min_video_bits_per_second": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+								},
+							},
+						},
+
+						"encryption": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"speke_key_provider": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"resource_id": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+
+												"system_ids": {
+													Type:     schema.TypeList,
+													Required: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+
+												"url": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+
+												"role_arn": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"cmaf_package": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ExactlyOneOf: []string{"hls_package", "dash_package", "cmaf_package", "mss_package"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"segment_duration_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+
+						"segment_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"hls_manifests": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"manifest_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"playlist_window_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+
+									"playlist_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"ad_markers": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"ad_triggers": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+
+									"ads_on_delivery_restrictions": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"program_date_time_interval_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+
+									"include_iframe_only_stream": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+
+						"stream_selection": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"stream_order": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"max_video_bits_per_second": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+
+									"min_video_bits_per_second": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+								},
+							},
+						},
+
+						"encryption": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"speke_key_provider": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"resource_id": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+
+												"system_ids": {
+													Type:     schema.TypeList,
+													Required: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+
+												"url": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+
+												"role_arn": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"mss_package": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ExactlyOneOf: []string{"hls_package", "dash_package", "cmaf_package", "mss_package"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"segment_duration_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+
+						"stream_selection": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"stream_order": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"max_video_bits_per_second": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+
+									"min_video_bits_per_second": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+								},
+							},
+						},
+
+						"encryption": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"constant_initialization_vector": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"encryption_method": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											mediapackage.EncryptionMethodAes128,
+											mediapackage.EncryptionMethodSampleAes,
+										}, false),
+									},
+
+									"key_rotation_interval_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+
+									"repeat_ext_x_key": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+
+									"speke_key_provider": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"resource_id": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+
+												"system_ids": {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+
+												"url": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+
+												"role_arn": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+
+												"certificate_arn": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -192,6 +548,18 @@ func resourceAwsMediaPackageOriginEndpointCreate(d *schema.ResourceData, meta in
 		input.HlsPackage = expandHlsPackage(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("dash_package"); ok {
+		input.DashPackage = expandDashPackage(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("cmaf_package"); ok {
+		input.CmafPackage = expandCmafPackageCreateOrUpdateParameters(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("mss_package"); ok {
+		input.MssPackage = expandMssPackage(v.(*schema.Set))
+	}
+
 	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
 		input.Tags = keyvaluetags.New(v).IgnoreAws().MedialiveTags()
 	}
@@ -233,6 +601,22 @@ func resourceAwsMediaPackageOriginEndpointRead(d *schema.ResourceData, meta inte
 		d.Set("authorization", flattenAuthorization(resp.Authorization))
 	}
 
+	if resp.HlsPackage != nil {
+		d.Set("hls_package", []interface{}{flattenHlsPackage(resp.HlsPackage)})
+	}
+
+	if resp.DashPackage != nil {
+		d.Set("dash_package", []interface{}{flattenDashPackage(resp.DashPackage)})
+	}
+
+	if resp.CmafPackage != nil {
+		d.Set("cmaf_package", []interface{}{flattenCmafPackage(resp.CmafPackage)})
+	}
+
+	if resp.MssPackage != nil {
+		d.Set("mss_package", []interface{}{flattenMssPackage(resp.MssPackage)})
+	}
+
 	if err := d.Set("tags", keyvaluetags.MedialiveKeyValueTags(resp.Tags).IgnoreAws().Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
@@ -243,6 +627,17 @@ func resourceAwsMediaPackageOriginEndpointRead(d *schema.ResourceData, meta inte
 func resourceAwsMediaPackageOriginEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).mediapackageconn
 
+	// UpdateOriginEndpoint can't move an endpoint from one packaging type to
+	// another (e.g. hls_package -> dash_package), so a type switch goes
+	// through Delete+Create instead of sending a request Origin Endpoint
+	// will reject or silently apply against the wrong package block.
+	if mediaPackagePackageTypeChanged(d) {
+		if err := resourceAwsMediaPackageOriginEndpointDelete(d, meta); err != nil {
+			return err
+		}
+		return resourceAwsMediaPackageOriginEndpointCreate(d, meta)
+	}
+
 	input := &mediapackage.UpdateOriginEndpointInput{
 		Id:                     aws.String(d.Get("endpoint_id").(string)),
 		Description:            aws.String(d.Get("description").(string)),
@@ -256,6 +651,18 @@ func resourceAwsMediaPackageOriginEndpointUpdate(d *schema.ResourceData, meta in
 		input.HlsPackage = expandHlsPackage(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("dash_package"); ok {
+		input.DashPackage = expandDashPackage(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("cmaf_package"); ok {
+		input.CmafPackage = expandCmafPackageCreateOrUpdateParameters(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("mss_package"); ok {
+		input.MssPackage = expandMssPackage(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("authorization"); ok {
 		input.Authorization = expandAuthorization(v.(*schema.Set))
 	}
@@ -285,10 +692,24 @@ func resourceAwsMediaPackageOriginEndpointDelete(d *schema.ResourceData, meta in
 	return nil
 }
 
+// mediaPackagePackageTypeChanged reports whether the configured packaging
+// block (hls_package/dash_package/cmaf_package/mss_package -- ExactlyOneOf
+// guarantees exactly one is ever set) is switching away from the one
+// recorded in state.
+func mediaPackagePackageTypeChanged(d *schema.ResourceData) bool {
+	for _, key := range []string{"hls_package", "dash_package", "cmaf_package", "mss_package"} {
+		o, n := d.GetChange(key)
+		if o.(*schema.Set).Len() > 0 && n.(*schema.Set).Len() == 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func expandHlsPackage(s *schema.Set) *mediapackage.HlsPackage {
 	if s.Len() > 0 {
 		rawSettings := s.List()[0].(map[string]interface{})
-		return &mediapackage.HlsPackage{
+		pkg := &mediapackage.HlsPackage{
 			SegmentDurationSeconds:         aws.Int64(int64(rawSettings["segment_duration_seconds"].(int))),
 			PlaylistWindowSeconds:          aws.Int64(int64(rawSettings["playlist_window_seconds"].(int))),
 			PlaylistType:                   aws.String(rawSettings["playlist_type"].(string)),
@@ -300,9 +721,229 @@ func expandHlsPackage(s *schema.Set) *mediapackage.HlsPackage {
 			UseAudioRenditionGroup:         aws.Bool(rawSettings["use_audio_rendition_group"].(bool)),
 			StreamSelection:                expandStreamSelection(rawSettings["stream_selection"].(*schema.Set)),
 		}
-	} else {
-		log.Printf("[ERROR] MediaPackage OriginEndpoint: HlsPackage settings can not be found")
-		return &mediapackage.HlsPackage{}
+
+		if v, ok := rawSettings["encryption"].(*schema.Set); ok && v.Len() > 0 {
+			pkg.Encryption = expandHlsEncryption(v)
+		}
+
+		return pkg
+	}
+	return nil
+}
+
+func expandHlsEncryption(s *schema.Set) *mediapackage.HlsEncryption {
+	settings := s.List()[0].(map[string]interface{})
+	return &mediapackage.HlsEncryption{
+		ConstantInitializationVector: aws.String(settings["constant_initialization_vector"].(string)),
+		EncryptionMethod:             aws.String(settings["encryption_method"].(string)),
+		KeyRotationIntervalSeconds:   aws.Int64(int64(settings["key_rotation_interval_seconds"].(int))),
+		RepeatExtXKey:                aws.Bool(settings["repeat_ext_x_key"].(bool)),
+		SpekeKeyProvider:             expandSpekeKeyProvider(settings["speke_key_provider"].(*schema.Set)),
+	}
+}
+
+func flattenHlsEncryption(enc *mediapackage.HlsEncryption) map[string]interface{} {
+	m := map[string]interface{}{
+		"constant_initialization_vector": aws.StringValue(enc.ConstantInitializationVector),
+		"encryption_method":              aws.StringValue(enc.EncryptionMethod),
+		"key_rotation_interval_seconds":  aws.Int64Value(enc.KeyRotationIntervalSeconds),
+		"repeat_ext_x_key":               aws.BoolValue(enc.RepeatExtXKey),
+	}
+
+	if enc.SpekeKeyProvider != nil {
+		m["speke_key_provider"] = []interface{}{flattenSpekeKeyProvider(enc.SpekeKeyProvider)}
+	}
+
+	return m
+}
+
+func flattenSpekeKeyProvider(provider *mediapackage.SpekeKeyProvider) map[string]interface{} {
+	return map[string]interface{}{
+		"resource_id":     aws.StringValue(provider.ResourceId),
+		"system_ids":      aws.StringValueSlice(provider.SystemIds),
+		"url":             aws.StringValue(provider.Url),
+		"role_arn":        aws.StringValue(provider.RoleArn),
+		"certificate_arn": aws.StringValue(provider.CertificateArn),
+	}
+}
+
+func expandDashPackage(s *schema.Set) *mediapackage.DashPackage {
+	if s.Len() > 0 {
+		rawSettings := s.List()[0].(map[string]interface{})
+		pkg := &mediapackage.DashPackage{
+			SegmentDurationSeconds:            aws.Int64(int64(rawSettings["segment_duration_seconds"].(int))),
+			ManifestLayout:                    aws.String(rawSettings["manifest_layout"].(string)),
+			MinBufferTimeSeconds:              aws.Int64(int64(rawSettings["min_buffer_time_seconds"].(int))),
+			MinUpdatePeriodSeconds:            aws.Int64(int64(rawSettings["min_update_period_seconds"].(int))),
+			SuggestedPresentationDelaySeconds: aws.Int64(int64(rawSettings["suggested_presentation_delay_seconds"].(int))),
+			PeriodTriggers:                    expandStringList(rawSettings["period_triggers"].([]interface{})),
+			Profile:                           aws.String(rawSettings["profile"].(string)),
+			SegmentTemplateFormat:             aws.String(rawSettings["segment_template_format"].(string)),
+			AdTriggers:                        expandStringList(rawSettings["ad_triggers"].([]interface{})),
+			AdsOnDeliveryRestrictions:         aws.String(rawSettings["ads_on_delivery_restrictions"].(string)),
+			StreamSelection:                   expandStreamSelection(rawSettings["stream_selection"].(*schema.Set)),
+		}
+
+		if v, ok := rawSettings["encryption"].(*schema.Set); ok && v.Len() > 0 {
+			pkg.Encryption = expandDashEncryption(v)
+		}
+
+		return pkg
+	}
+
+
+	return nil
+}
+
+func expandDashEncryption(s *schema.Set) *mediapackage.DashEncryption {
+	settings := s.List()[0].(map[string]interface{})
+	return &mediapackage.DashEncryption{
+		SpekeKeyProvider: expandSpekeKeyProvider(settings["speke_key_provider"].(*schema.Set)),
+	}
+}
+
+func flattenDashPackage(pkg *mediapackage.DashPackage) map[string]interface{} {
+	m := map[string]interface{}{
+		"segment_duration_seconds":             aws.Int64Value(pkg.SegmentDurationSeconds),
+		"manifest_layout":                      aws.StringValue(pkg.ManifestLayout),
+		"min_buffer_time_seconds":              aws.Int64Value(pkg.MinBufferTimeSeconds),
+		"min_update_period_seconds":            aws.Int64Value(pkg.MinUpdatePeriodSeconds),
+		"suggested_presentation_delay_seconds": aws.Int64Value(pkg.SuggestedPresentationDelaySeconds),
+		"period_triggers":                      aws.StringValueSlice(pkg.PeriodTriggers),
+		"profile":                              aws.StringValue(pkg.Profile),
+		"segment_template_format":              aws.StringValue(pkg.SegmentTemplateFormat),
+		"ad_triggers":                          aws.StringValueSlice(pkg.AdTriggers),
+		"ads_on_delivery_restrictions":         aws.StringValue(pkg.AdsOnDeliveryRestrictions),
+	}
+
+	if pkg.StreamSelection != nil {
+		m["stream_selection"] = []interface{}{flattenStreamSelection(pkg.StreamSelection)}
+	}
+
+	if pkg.Encryption != nil {
+		m["encryption"] = []interface{}{map[string]interface{}{
+			"speke_key_provider": []interface{}{flattenSpekeKeyProvider(pkg.Encryption.SpekeKeyProvider)},
+		}}
+	}
+
+	return m
+}
+
+func expandCmafPackageCreateOrUpdateParameters(s *schema.Set) *mediapackage.CmafPackageCreateOrUpdateParameters {
+	if s.Len() > 0 {
+		rawSettings := s.List()[0].(map[string]interface{})
+		pkg := &mediapackage.CmafPackageCreateOrUpdateParameters{
+			SegmentDurationSeconds: aws.Int64(int64(rawSettings["segment_duration_seconds"].(int))),
+			SegmentPrefix:          aws.String(rawSettings["segment_prefix"].(string)),
+			StreamSelection:        expandStreamSelection(rawSettings["stream_selection"].(*schema.Set)),
+			HlsManifests:           expandHlsManifests(rawSettings["hls_manifests"].([]interface{})),
+		}
+
+		if v, ok := rawSettings["encryption"].(*schema.Set); ok && v.Len() > 0 {
+			pkg.Encryption = expandCmafEncryption(v)
+		}
+
+		return pkg
+	}
+
+
+	return nil
+}
+
+func expandCmafEncryption(s *schema.Set) *mediapackage.CmafEncryption {
+	settings := s.List()[0].(map[string]interface{})
+	return &mediapackage.CmafEncryption{
+		SpekeKeyProvider: expandSpekeKeyProvider(settings["speke_key_provider"].(*schema.Set)),
+	}
+}
+
+func expandHlsManifests(l []interface{}) []*mediapackage.HlsManifestCreateOrUpdateParameters {
+	manifests := make([]*mediapackage.HlsManifestCreateOrUpdateParameters, 0, len(l))
+	for _, raw := range l {
+		settings := raw.(map[string]interface{})
+		manifests = append(manifests, &mediapackage.HlsManifestCreateOrUpdateParameters{
+			ManifestName:                   aws.String(settings["manifest_name"].(string)),
+			PlaylistWindowSeconds:          aws.Int64(int64(settings["playlist_window_seconds"].(int))),
+			PlaylistType:                   aws.String(settings["playlist_type"].(string)),
+			AdMarkers:                      aws.String(settings["ad_markers"].(string)),
+			AdTriggers:                     expandStringList(settings["ad_triggers"].([]interface{})),
+			AdsOnDeliveryRestrictions:      aws.String(settings["ads_on_delivery_restrictions"].(string)),
+			ProgramDateTimeIntervalSeconds: aws.Int64(int64(settings["program_date_time_interval_seconds"].(int))),
+			IncludeIframeOnlyStream:        aws.Bool(settings["include_iframe_only_stream"].(bool)),
+		})
+	}
+	return manifests
+}
+
+func flattenCmafPackage(pkg *mediapackage.CmafPackage) map[string]interface{} {
+	m := map[string]interface{}{
+		"segment_duration_seconds": aws.Int64Value(pkg.SegmentDurationSeconds),
+		"segment_prefix":           aws.StringValue(pkg.SegmentPrefix),
+		"hls_manifests":            flattenHlsManifests(pkg.HlsManifests),
+	}
+
+	if pkg.StreamSelection != nil {
+		m["stream_selection"] = []interface{}{flattenStreamSelection(pkg.StreamSelection)}
+	}
+
+	if pkg.Encryption != nil {
+		m["encryption"] = []interface{}{map[string]interface{}{
+			"speke_key_provider": []interface{}{flattenSpekeKeyProvider(pkg.Encryption.SpekeKeyProvider)},
+		}}
+	}
+
+	return m
+}
+
+func flattenHlsManifests(manifests []*mediapackage.HlsManifest) []interface{} {
+	out := make([]interface{}, 0, len(manifests))
+	for _, manifest := range manifests {
+		out = append(out, map[string]interface{}{
+			"manifest_name":                      aws.StringValue(manifest.ManifestName),
+			"playlist_window_seconds":            aws.Int64Value(manifest.PlaylistWindowSeconds),
+			"playlist_type":                      aws.StringValue(manifest.PlaylistType),
+			"ad_markers":                         aws.StringValue(manifest.AdMarkers),
+			"ad_triggers":                        aws.StringValueSlice(manifest.AdTriggers),
+			"ads_on_delivery_restrictions":       aws.StringValue(manifest.AdsOnDeliveryRestrictions),
+			"program_date_time_interval_seconds": aws.Int64Value(manifest.ProgramDateTimeIntervalSeconds),
+			"include_iframe_only_stream":         aws.BoolValue(manifest.IncludeIframeOnlyStream),
+		})
+	}
+	return out
+}
+
+func flattenMssPackage(pkg *mediapackage.MssPackage) map[string]interface{} {
+	m := map[string]interface{}{
+		"segment_duration_seconds": aws.Int64Value(pkg.SegmentDurationSeconds),
+	}
+
+	if pkg.StreamSelection != nil {
+		m["stream_selection"] = []interface{}{flattenStreamSelection(pkg.StreamSelection)}
+	}
+
+	return m
+}
+
+func expandMssPackage(s *schema.Set) *mediapackage.MssPackage {
+	if s.Len() > 0 {
+		rawSettings := s.List()[0].(map[string]interface{})
+		return &mediapackage.MssPackage{
+			SegmentDurationSeconds: aws.Int64(int64(rawSettings["segment_duration_seconds"].(int))),
+			StreamSelection:        expandStreamSelection(rawSettings["stream_selection"].(*schema.Set)),
+		}
+	}
+
+
+	return nil
+}
+
+func expandSpekeKeyProvider(s *schema.Set) *mediapackage.SpekeKeyProvider {
+	settings := s.List()[0].(map[string]interface{})
+	return &mediapackage.SpekeKeyProvider{
+		ResourceId: aws.String(settings["resource_id"].(string)),
+		SystemIds:  expandStringList(settings["system_ids"].([]interface{})),
+		Url:        aws.String(settings["url"].(string)),
+		RoleArn:    aws.String(settings["role_arn"].(string)),
 	}
 }
 
@@ -314,10 +955,8 @@ func expandStreamSelection(s *schema.Set) *mediapackage.StreamSelection {
 			MinVideoBitsPerSecond: aws.Int64(int64(settings["min_video_bits_per_second"].(int))),
 			StreamOrder:           aws.String(settings["stream_order"].(string)),
 		}
-	} else {
-		log.Printf("[ERROR] MediaPackage OriginEndpoint: StreamSelection settings can not be found")
-		return &mediapackage.StreamSelection{}
 	}
+	return nil
 }
 
 func expandAuthorization(s *schema.Set) *mediapackage.Authorization {
@@ -332,6 +971,38 @@ func expandAuthorization(s *schema.Set) *mediapackage.Authorization {
 	}
 }
 
+func flattenHlsPackage(pkg *mediapackage.HlsPackage) map[string]interface{} {
+	m := map[string]interface{}{
+		"segment_duration_seconds":           aws.Int64Value(pkg.SegmentDurationSeconds),
+		"playlist_window_seconds":            aws.Int64Value(pkg.PlaylistWindowSeconds),
+		"playlist_type":                      aws.StringValue(pkg.PlaylistType),
+		"ad_markers":                         aws.StringValue(pkg.AdMarkers),
+		"ad_triggers":                        aws.StringValueSlice(pkg.AdTriggers),
+		"ads_on_delivery_restrictions":       aws.StringValue(pkg.AdsOnDeliveryRestrictions),
+		"program_date_time_interval_seconds": aws.Int64Value(pkg.ProgramDateTimeIntervalSeconds),
+		"include_iframe_only_stream":         aws.BoolValue(pkg.IncludeIframeOnlyStream),
+		"use_audio_rendition_group":          aws.BoolValue(pkg.UseAudioRenditionGroup),
+	}
+
+	if pkg.StreamSelection != nil {
+		m["stream_selection"] = []interface{}{flattenStreamSelection(pkg.StreamSelection)}
+	}
+
+	if pkg.Encryption != nil {
+		m["encryption"] = []interface{}{flattenHlsEncryption(pkg.Encryption)}
+	}
+
+	return m
+}
+
+func flattenStreamSelection(sel *mediapackage.StreamSelection) map[string]interface{} {
+	return map[string]interface{}{
+		"stream_order":              aws.StringValue(sel.StreamOrder),
+		"max_video_bits_per_second": aws.Int64Value(sel.MaxVideoBitsPerSecond),
+		"min_video_bits_per_second": aws.Int64Value(sel.MinVideoBitsPerSecond),
+	}
+}
+
 func flattenAuthorization(auth *mediapackage.Authorization) map[string]interface{} {
 	m := map[string]interface{}{
 		"cdn_identifier_secret": aws.StringValue(auth.CdnIdentifierSecret),
@@ -0,0 +1,163 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediapackage"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSMediaPackageOriginEndpoint_packageTypeChange(t *testing.T) {
+	var endpoint, endpointBefore mediapackage.OriginEndpoint
+
+	resourceName := "aws_media_package_origin_endpoint.test"
+	rName := fmt.Sprintf("tf-testacc-mpoe-%s", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, mediapackage.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaPackageOriginEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaPackageOriginEndpointConfig_hls(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaPackageOriginEndpointExists(resourceName, &endpointBefore),
+					resource.TestCheckResourceAttr(resourceName, "hls_package.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.#", "0"),
+				),
+			},
+			{
+				// Same packaging type, different setting: should be an
+				// in-place update, not a replacement.
+				Config: testAccAWSMediaPackageOriginEndpointConfig_hlsUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaPackageOriginEndpointExists(resourceName, &endpoint),
+					testAccCheckAWSMediaPackageOriginEndpointNotRecreated(&endpointBefore, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "hls_package.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "hls_package.*", map[string]string{"playlist_window_seconds": "120"}),
+				),
+			},
+			{
+				// hls_package -> dash_package: mediaPackagePackageTypeChanged
+				// should drive this through Delete+Create.
+				Config: testAccAWSMediaPackageOriginEndpointConfig_dash(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaPackageOriginEndpointExists(resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "hls_package.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMediaPackageOriginEndpointNotRecreated(before, after *mediapackage.OriginEndpoint) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(after.Arn) != aws.StringValue(before.Arn) {
+			return fmt.Errorf("expected the same Origin Endpoint ARN %s across a same-type edit, got %s", aws.StringValue(before.Arn), aws.StringValue(after.Arn))
+		}
+		return nil
+	}
+}
+
+func testAccCheckAWSMediaPackageOriginEndpointExists(n string, endpoint *mediapackage.OriginEndpoint) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).mediapackageconn
+		resp, err := conn.DescribeOriginEndpoint(&mediapackage.DescribeOriginEndpointInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		endpoint.Arn = resp.Arn
+		endpoint.Id = resp.Id
+
+		return nil
+	}
+}
+
+func testAccCheckAWSMediaPackageOriginEndpointDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).mediapackageconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_media_package_origin_endpoint" {
+			continue
+		}
+
+		_, err := conn.DescribeOriginEndpoint(&mediapackage.DescribeOriginEndpointInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("MediaPackage Origin Endpoint %s still exists", rs.Primary.ID)
+		}
+		if !isAWSErr(err, mediapackage.ErrCodeNotFoundException, "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSMediaPackageOriginEndpointConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_media_package_channel" "test" {
+  channel_id  = %[1]q
+  description = "tf-testacc"
+}
+`, rName)
+}
+
+func testAccAWSMediaPackageOriginEndpointConfig_hls(rName string) string {
+	return testAccAWSMediaPackageOriginEndpointConfig_base(rName) + fmt.Sprintf(`
+resource "aws_media_package_origin_endpoint" "test" {
+  channel_id  = aws_media_package_channel.test.id
+  endpoint_id = %[1]q
+
+  hls_package {
+    segment_duration_seconds = 10
+    playlist_window_seconds  = 60
+    playlist_type             = "EVENT"
+  }
+}
+`, rName)
+}
+
+func testAccAWSMediaPackageOriginEndpointConfig_hlsUpdated(rName string) string {
+	return testAccAWSMediaPackageOriginEndpointConfig_base(rName) + fmt.Sprintf(`
+resource "aws_media_package_origin_endpoint" "test" {
+  channel_id  = aws_media_package_channel.test.id
+  endpoint_id = %[1]q
+
+  hls_package {
+    segment_duration_seconds = 10
+    playlist_window_seconds  = 120
+    playlist_type             = "EVENT"
+  }
+}
+`, rName)
+}
+
+func testAccAWSMediaPackageOriginEndpointConfig_dash(rName string) string {
+	return testAccAWSMediaPackageOriginEndpointConfig_base(rName) + fmt.Sprintf(`
+resource "aws_media_package_origin_endpoint" "test" {
+  channel_id  = aws_media_package_channel.test.id
+  endpoint_id = %[1]q
+
+  dash_package {
+    segment_duration_seconds = 10
+    manifest_layout           = "FULL"
+  }
+}
+`, rName)
+}
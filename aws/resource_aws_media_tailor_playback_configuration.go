@@ -0,0 +1,460 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediatailor"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsMediaTailorPlaybackConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaTailorPlaybackConfigurationCreate,
+		Read:   resourceAwsMediaTailorPlaybackConfigurationRead,
+		Update: resourceAwsMediaTailorPlaybackConfigurationUpdate,
+		Delete: resourceAwsMediaTailorPlaybackConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"ad_decision_server_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"video_content_source_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"slate_ad_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"transcode_profile_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"personalization_threshold_seconds": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"cdn_configuration": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ad_segment_url_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"content_segment_url_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"dash_configuration": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"manifest_endpoint_prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"mpd_location": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"origin_manifest_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"hls_configuration": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"manifest_endpoint_prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"avail_suppression": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"bumper": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_url": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"end_url": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"live_pre_roll_configuration": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ad_decision_server_url": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"max_duration_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"manifest_processing_rules": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ad_marker_passthrough_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"playback_endpoint_prefix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"session_initialization_endpoint_prefix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsMediaTailorPlaybackConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mediatailorconn
+
+	name := d.Get("name").(string)
+	input := expandMediaTailorPlaybackConfiguration(d)
+	input.Name = aws.String(name)
+
+	_, err := conn.PutPlaybackConfiguration(input)
+	if err != nil {
+		return fmt.Errorf("Error creating MediaTailor Playback Configuration: %s", err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsMediaTailorPlaybackConfigurationRead(d, meta)
+}
+
+func resourceAwsMediaTailorPlaybackConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mediatailorconn
+
+	resp, err := conn.GetPlaybackConfiguration(&mediatailor.GetPlaybackConfigurationInput{
+		Name: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, mediatailor.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] MediaTailor Playback Configuration %s not found, error code (404)", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error describing MediaTailor Playback Configuration(%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", aws.StringValue(resp.PlaybackConfigurationArn))
+	d.Set("name", aws.StringValue(resp.Name))
+	d.Set("ad_decision_server_url", aws.StringValue(resp.AdDecisionServerUrl))
+	d.Set("video_content_source_url", aws.StringValue(resp.VideoContentSourceUrl))
+	d.Set("slate_ad_url", aws.StringValue(resp.SlateAdUrl))
+	d.Set("transcode_profile_name", aws.StringValue(resp.TranscodeProfileName))
+	d.Set("personalization_threshold_seconds", aws.Int64Value(resp.PersonalizationThresholdSeconds))
+	d.Set("playback_endpoint_prefix", aws.StringValue(resp.PlaybackEndpointPrefix))
+	d.Set("session_initialization_endpoint_prefix", aws.StringValue(resp.SessionInitializationEndpointPrefix))
+
+	if resp.CdnConfiguration != nil {
+		d.Set("cdn_configuration", []interface{}{flattenMediaTailorCdnConfiguration(resp.CdnConfiguration)})
+	}
+
+	if resp.DashConfiguration != nil {
+		d.Set("dash_configuration", []interface{}{flattenMediaTailorDashConfiguration(resp.DashConfiguration)})
+	}
+
+	if resp.HlsConfiguration != nil {
+		d.Set("hls_configuration", []interface{}{flattenMediaTailorHlsConfiguration(resp.HlsConfiguration)})
+	}
+
+	if resp.AvailSuppression != nil {
+		d.Set("avail_suppression", []interface{}{flattenMediaTailorAvailSuppression(resp.AvailSuppression)})
+	}
+
+	if resp.Bumper != nil {
+		d.Set("bumper", []interface{}{flattenMediaTailorBumper(resp.Bumper)})
+	}
+
+	if resp.LivePreRollConfiguration != nil {
+		d.Set("live_pre_roll_configuration", []interface{}{flattenMediaTailorLivePreRollConfiguration(resp.LivePreRollConfiguration)})
+	}
+
+	if resp.ManifestProcessingRules != nil {
+		d.Set("manifest_processing_rules", []interface{}{flattenMediaTailorManifestProcessingRules(resp.ManifestProcessingRules)})
+	}
+
+	if err := d.Set("tags", keyvaluetags.MediatailorKeyValueTags(resp.Tags).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaTailorPlaybackConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mediatailorconn
+
+	// MediaTailor has no separate update API -- PutPlaybackConfiguration is a
+	// full-replace upsert keyed on Name, so a changed attribute is applied by
+	// putting the configuration again.
+	input := expandMediaTailorPlaybackConfiguration(d)
+	input.Name = aws.String(d.Id())
+
+	_, err := conn.PutPlaybackConfiguration(input)
+	if err != nil {
+		return fmt.Errorf("Error updating MediaTailor Playback Configuration: %s", err)
+	}
+
+	return resourceAwsMediaTailorPlaybackConfigurationRead(d, meta)
+}
+
+func resourceAwsMediaTailorPlaybackConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mediatailorconn
+
+	_, err := conn.DeletePlaybackConfiguration(&mediatailor.DeletePlaybackConfigurationInput{
+		Name: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, mediatailor.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting MediaTailor Playback Configuration(%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandMediaTailorPlaybackConfiguration(d *schema.ResourceData) *mediatailor.PutPlaybackConfigurationInput {
+	input := &mediatailor.PutPlaybackConfigurationInput{
+		AdDecisionServerUrl:             aws.String(d.Get("ad_decision_server_url").(string)),
+		VideoContentSourceUrl:           aws.String(d.Get("video_content_source_url").(string)),
+		SlateAdUrl:                      aws.String(d.Get("slate_ad_url").(string)),
+		TranscodeProfileName:            aws.String(d.Get("transcode_profile_name").(string)),
+		PersonalizationThresholdSeconds: aws.Int64(int64(d.Get("personalization_threshold_seconds").(int))),
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().MediatailorTags()
+	}
+
+	if v, ok := d.GetOk("cdn_configuration"); ok {
+		input.CdnConfiguration = expandMediaTailorCdnConfiguration(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("dash_configuration"); ok {
+		input.DashConfiguration = expandMediaTailorDashConfiguration(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("avail_suppression"); ok {
+		input.AvailSuppression = expandMediaTailorAvailSuppression(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("bumper"); ok {
+		input.Bumper = expandMediaTailorBumper(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("live_pre_roll_configuration"); ok {
+		input.LivePreRollConfiguration = expandMediaTailorLivePreRollConfiguration(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("manifest_processing_rules"); ok {
+		input.ManifestProcessingRules = expandMediaTailorManifestProcessingRules(v.(*schema.Set))
+	}
+
+	return input
+}
+
+func expandMediaTailorCdnConfiguration(s *schema.Set) *mediatailor.CdnConfiguration {
+	if s.Len() == 0 {
+		return nil
+	}
+	settings := s.List()[0].(map[string]interface{})
+	return &mediatailor.CdnConfiguration{
+		AdSegmentUrlPrefix:      aws.String(settings["ad_segment_url_prefix"].(string)),
+		ContentSegmentUrlPrefix: aws.String(settings["content_segment_url_prefix"].(string)),
+	}
+}
+
+func flattenMediaTailorCdnConfiguration(c *mediatailor.CdnConfiguration) map[string]interface{} {
+	return map[string]interface{}{
+		"ad_segment_url_prefix":      aws.StringValue(c.AdSegmentUrlPrefix),
+		"content_segment_url_prefix": aws.StringValue(c.ContentSegmentUrlPrefix),
+	}
+}
+
+func expandMediaTailorDashConfiguration(s *schema.Set) *mediatailor.DashConfigurationForPut {
+	if s.Len() == 0 {
+		return nil
+	}
+	settings := s.List()[0].(map[string]interface{})
+	return &mediatailor.DashConfigurationForPut{
+		MpdLocation:        aws.String(settings["mpd_location"].(string)),
+		OriginManifestType: aws.String(settings["origin_manifest_type"].(string)),
+	}
+}
+
+func flattenMediaTailorDashConfiguration(c *mediatailor.DashConfiguration) map[string]interface{} {
+	return map[string]interface{}{
+		"manifest_endpoint_prefix": aws.StringValue(c.ManifestEndpointPrefix),
+		"mpd_location":             aws.StringValue(c.MpdLocation),
+		"origin_manifest_type":     aws.StringValue(c.OriginManifestType),
+	}
+}
+
+func flattenMediaTailorHlsConfiguration(c *mediatailor.HlsConfiguration) map[string]interface{} {
+	return map[string]interface{}{
+		"manifest_endpoint_prefix": aws.StringValue(c.ManifestEndpointPrefix),
+	}
+}
+
+func expandMediaTailorAvailSuppression(s *schema.Set) *mediatailor.AvailSuppression {
+	if s.Len() == 0 {
+		return nil
+	}
+	settings := s.List()[0].(map[string]interface{})
+	return &mediatailor.AvailSuppression{
+		Mode:  aws.String(settings["mode"].(string)),
+		Value: aws.String(settings["value"].(string)),
+	}
+}
+
+func flattenMediaTailorAvailSuppression(a *mediatailor.AvailSuppression) map[string]interface{} {
+	return map[string]interface{}{
+		"mode":  aws.StringValue(a.Mode),
+		"value": aws.StringValue(a.Value),
+	}
+}
+
+func expandMediaTailorBumper(s *schema.Set) *mediatailor.Bumper {
+	if s.Len() == 0 {
+		return nil
+	}
+	settings := s.List()[0].(map[string]interface{})
+	return &mediatailor.Bumper{
+		StartUrl: aws.String(settings["start_url"].(string)),
+		EndUrl:   aws.String(settings["end_url"].(string)),
+	}
+}
+
+func flattenMediaTailorBumper(b *mediatailor.Bumper) map[string]interface{} {
+	return map[string]interface{}{
+		"start_url": aws.StringValue(b.StartUrl),
+		"end_url":   aws.StringValue(b.EndUrl),
+	}
+}
+
+func expandMediaTailorLivePreRollConfiguration(s *schema.Set) *mediatailor.LivePreRollConfiguration {
+	if s.Len() == 0 {
+		return nil
+	}
+	settings := s.List()[0].(map[string]interface{})
+	return &mediatailor.LivePreRollConfiguration{
+		AdDecisionServerUrl: aws.String(settings["ad_decision_server_url"].(string)),
+		MaxDurationSeconds:  aws.Int64(int64(settings["max_duration_seconds"].(int))),
+	}
+}
+
+func flattenMediaTailorLivePreRollConfiguration(c *mediatailor.LivePreRollConfiguration) map[string]interface{} {
+	return map[string]interface{}{
+		"ad_decision_server_url": aws.StringValue(c.AdDecisionServerUrl),
+		"max_duration_seconds":   aws.Int64Value(c.MaxDurationSeconds),
+	}
+}
+
+func expandMediaTailorManifestProcessingRules(s *schema.Set) *mediatailor.ManifestProcessingRules {
+	if s.Len() == 0 {
+		return nil
+	}
+	settings := s.List()[0].(map[string]interface{})
+	return &mediatailor.ManifestProcessingRules{
+		AdMarkerPassthrough: &mediatailor.AdMarkerPassthrough{
+			Enabled: aws.Bool(settings["ad_marker_passthrough_enabled"].(bool)),
+		},
+	}
+}
+
+func flattenMediaTailorManifestProcessingRules(r *mediatailor.ManifestProcessingRules) map[string]interface{} {
+	m := map[string]interface{}{}
+	if r.AdMarkerPassthrough != nil {
+		m["ad_marker_passthrough_enabled"] = aws.BoolValue(r.AdMarkerPassthrough.Enabled)
+	}
+	return m
+}
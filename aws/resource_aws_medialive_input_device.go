@@ -0,0 +1,239 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// resourceAwsMediaLiveInputDevice does not create or delete a physical
+// Link/HD/UHD encoder device -- those are paired to an AWS account out of
+// band (see dataSourceAwsMediaLiveInputDevice for pairing by serial number,
+// and resourceAwsMediaLiveInputDeviceTransfer for moving a device between
+// accounts). Create and Update both resolve to UpdateInputDevice; Delete
+// only drops the resource from state, since the device itself continues to
+// exist and stay paired after this resource is removed.
+func resourceAwsMediaLiveInputDevice() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaLiveInputDeviceCreate,
+		Read:   resourceAwsMediaLiveInputDeviceRead,
+		Update: resourceAwsMediaLiveInputDeviceUpdate,
+		Delete: resourceAwsMediaLiveInputDeviceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"input_device_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"serial_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"mac_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"connection_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"hd_device_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: mediaLiveInputDeviceConfigurableSettingsSchema(),
+				},
+			},
+
+			"uhd_device_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: mediaLiveInputDeviceConfigurableSettingsSchema(),
+				},
+			},
+		},
+	}
+}
+
+func mediaLiveInputDeviceConfigurableSettingsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"configured_input": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				medialive.InputDeviceConfiguredInputAuto,
+				medialive.InputDeviceConfiguredInputHdmi,
+				medialive.InputDeviceConfiguredInputSdi,
+			}, false),
+		},
+
+		"latency_ms": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+
+		"max_bitrate": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+	}
+}
+
+func resourceAwsMediaLiveInputDeviceCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("input_device_id").(string))
+
+	if err := updateMediaLiveInputDeviceSettings(d, meta); err != nil {
+		return err
+	}
+
+	return resourceAwsMediaLiveInputDeviceRead(d, meta)
+}
+
+func resourceAwsMediaLiveInputDeviceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	resp, err := conn.DescribeInputDevice(&medialive.DescribeInputDeviceInput{
+		InputDeviceId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] MediaLive Input Device %s not found, error code (404)", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error describing MediaLive Input Device(%s): %s", d.Id(), err)
+	}
+
+	d.Set("input_device_id", aws.StringValue(resp.Id))
+	d.Set("name", aws.StringValue(resp.Name))
+	d.Set("arn", aws.StringValue(resp.Arn))
+	d.Set("type", aws.StringValue(resp.Type))
+	d.Set("serial_number", aws.StringValue(resp.SerialNumber))
+	d.Set("mac_address", aws.StringValue(resp.MacAddress))
+	d.Set("connection_state", aws.StringValue(resp.ConnectionState))
+
+	if err := d.Set("hd_device_settings", flattenInputDeviceHdSettings(resp.HdDeviceSettings)); err != nil {
+		return fmt.Errorf("error setting hd_device_settings: %s", err)
+	}
+
+	if err := d.Set("uhd_device_settings", flattenInputDeviceUhdSettings(resp.UhdDeviceSettings)); err != nil {
+		return fmt.Errorf("error setting uhd_device_settings: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaLiveInputDeviceUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := updateMediaLiveInputDeviceSettings(d, meta); err != nil {
+		return err
+	}
+
+	return resourceAwsMediaLiveInputDeviceRead(d, meta)
+}
+
+func resourceAwsMediaLiveInputDeviceDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func updateMediaLiveInputDeviceSettings(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	input := &medialive.UpdateInputDeviceInput{
+		InputDeviceId: aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("hd_device_settings"); ok && len(v.([]interface{})) > 0 {
+		input.HdDeviceSettings = expandInputDeviceConfigurableSettings(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("uhd_device_settings"); ok && len(v.([]interface{})) > 0 {
+		input.UhdDeviceSettings = expandInputDeviceConfigurableSettings(v.([]interface{}))
+	}
+
+	if _, err := conn.UpdateInputDevice(input); err != nil {
+		return fmt.Errorf("Error updating MediaLive Input Device(%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandInputDeviceConfigurableSettings(tfList []interface{}) *medialive.InputDeviceConfigurableSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+	m := tfList[0].(map[string]interface{})
+
+	result := &medialive.InputDeviceConfigurableSettings{}
+	if v := m["configured_input"].(string); v != "" {
+		result.ConfiguredInput = aws.String(v)
+	}
+	if v := m["latency_ms"].(int); v > 0 {
+		result.LatencyMs = aws.Int64(int64(v))
+	}
+	if v := m["max_bitrate"].(int); v > 0 {
+		result.MaxBitrate = aws.Int64(int64(v))
+	}
+	return result
+}
+
+func flattenInputDeviceHdSettings(settings *medialive.InputDeviceHdSettings) []interface{} {
+	if settings == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"configured_input": aws.StringValue(settings.ConfiguredInput),
+			"latency_ms":       aws.Int64Value(settings.LatencyMs),
+			"max_bitrate":      aws.Int64Value(settings.MaxBitrate),
+		},
+	}
+}
+
+func flattenInputDeviceUhdSettings(settings *medialive.InputDeviceUhdSettings) []interface{} {
+	if settings == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"configured_input": aws.StringValue(settings.ConfiguredInput),
+			"latency_ms":       aws.Int64Value(settings.LatencyMs),
+			"max_bitrate":      aws.Int64Value(settings.MaxBitrate),
+		},
+	}
+}
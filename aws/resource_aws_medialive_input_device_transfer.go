@@ -0,0 +1,125 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// resourceAwsMediaLiveInputDeviceTransfer manages one side of a MediaLive
+// input device account transfer. Configuring target_customer_id starts an
+// outgoing transfer of a device this account owns (TransferInputDevice);
+// leaving it unset accepts a pending incoming transfer instead
+// (AcceptInputDeviceTransfer). Either way, Delete cancels the transfer
+// (CancelInputDeviceTransfer) rather than transferring the device back, since
+// MediaLive has no API to reverse a transfer that already completed.
+func resourceAwsMediaLiveInputDeviceTransfer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaLiveInputDeviceTransferCreate,
+		Read:   resourceAwsMediaLiveInputDeviceTransferRead,
+		Delete: resourceAwsMediaLiveInputDeviceTransferDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"input_device_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Set to start an outgoing transfer of a device this account owns.
+			// Leave unset to accept a pending incoming transfer instead.
+			"target_customer_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"target_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"transfer_message": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsMediaLiveInputDeviceTransferCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	deviceId := d.Get("input_device_id").(string)
+
+	if v, ok := d.GetOk("target_customer_id"); ok {
+		input := &medialive.TransferInputDeviceInput{
+			InputDeviceId:    aws.String(deviceId),
+			TargetCustomerId: aws.String(v.(string)),
+		}
+		if v, ok := d.GetOk("target_region"); ok {
+			input.TargetRegion = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("transfer_message"); ok {
+			input.TransferMessage = aws.String(v.(string))
+		}
+		if _, err := conn.TransferInputDevice(input); err != nil {
+			return fmt.Errorf("Error transferring MediaLive Input Device (%s): %s", deviceId, err)
+		}
+	} else {
+		input := &medialive.AcceptInputDeviceTransferInput{
+			InputDeviceId: aws.String(deviceId),
+		}
+		if _, err := conn.AcceptInputDeviceTransfer(input); err != nil {
+			return fmt.Errorf("Error accepting transfer of MediaLive Input Device (%s): %s", deviceId, err)
+		}
+	}
+
+	d.SetId(deviceId)
+
+	return resourceAwsMediaLiveInputDeviceTransferRead(d, meta)
+}
+
+func resourceAwsMediaLiveInputDeviceTransferRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	resp, err := conn.DescribeInputDevice(&medialive.DescribeInputDeviceInput{
+		InputDeviceId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] MediaLive Input Device %s not found, error code (404)", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error describing MediaLive Input Device(%s): %s", d.Id(), err)
+	}
+
+	d.Set("input_device_id", aws.StringValue(resp.Id))
+
+	return nil
+}
+
+func resourceAwsMediaLiveInputDeviceTransferDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	_, err := conn.CancelInputDeviceTransfer(&medialive.CancelInputDeviceTransferInput{
+		InputDeviceId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error canceling transfer of MediaLive Input Device (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsMediaLiveInputSecurityGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaLiveInputSecurityGroupCreate,
+		Read:   resourceAwsMediaLiveInputSecurityGroupRead,
+		Update: resourceAwsMediaLiveInputSecurityGroupUpdate,
+		Delete: resourceAwsMediaLiveInputSecurityGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"whitelist_rules": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsMediaLiveInputSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	input := &medialive.CreateInputSecurityGroupInput{
+		WhitelistRules: expandMediaLiveInputWhitelistRules(d.Get("whitelist_rules").([]interface{})),
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().MedialiveTags()
+	}
+
+	resp, err := conn.CreateInputSecurityGroup(input)
+	if err != nil {
+		return fmt.Errorf("Error creating MediaLive Input Security Group: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.SecurityGroup.Id))
+
+	return resourceAwsMediaLiveInputSecurityGroupRead(d, meta)
+}
+
+func resourceAwsMediaLiveInputSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	resp, err := conn.DescribeInputSecurityGroup(&medialive.DescribeInputSecurityGroupInput{
+		InputSecurityGroupId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] MediaLive Input Security Group %s not found, error code (404)", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error describing MediaLive Input Security Group(%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", aws.StringValue(resp.Arn))
+
+	if err := d.Set("whitelist_rules", flattenMediaLiveInputWhitelistRules(resp.WhitelistRules)); err != nil {
+		return fmt.Errorf("error setting whitelist_rules: %s", err)
+	}
+
+	if err := d.Set("tags", keyvaluetags.MedialiveKeyValueTags(resp.Tags).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaLiveInputSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	if d.HasChange("whitelist_rules") {
+		input := &medialive.UpdateInputSecurityGroupInput{
+			InputSecurityGroupId: aws.String(d.Id()),
+			WhitelistRules:       expandMediaLiveInputWhitelistRules(d.Get("whitelist_rules").([]interface{})),
+		}
+
+		if _, err := conn.UpdateInputSecurityGroup(input); err != nil {
+			return fmt.Errorf("Error updating MediaLive Input Security Group(%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+		if err := keyvaluetags.MedialiveUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating tags: %s", err)
+		}
+	}
+
+	return resourceAwsMediaLiveInputSecurityGroupRead(d, meta)
+}
+
+func resourceAwsMediaLiveInputSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	_, err := conn.DeleteInputSecurityGroup(&medialive.DeleteInputSecurityGroupInput{
+		InputSecurityGroupId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting MediaLive Input Security Group(%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandMediaLiveInputWhitelistRules(rules []interface{}) []*medialive.InputWhitelistRuleCidr {
+	var result []*medialive.InputWhitelistRuleCidr
+	if len(rules) == 0 {
+		return nil
+	}
+
+	for _, rule := range rules {
+		r := rule.(map[string]interface{})
+
+		result = append(result, &medialive.InputWhitelistRuleCidr{
+			Cidr: aws.String(r["cidr"].(string)),
+		})
+	}
+	return result
+}
+
+func flattenMediaLiveInputWhitelistRules(rules []*medialive.InputWhitelistRule) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, map[string]interface{}{
+			"cidr": aws.StringValue(rule.Cidr),
+		})
+	}
+	return result
+}
@@ -0,0 +1,314 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/medialive/waiter"
+)
+
+func resourceAwsMediaLiveMultiplex() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaLiveMultiplexCreate,
+		Read:   resourceAwsMediaLiveMultiplexRead,
+		Update: resourceAwsMediaLiveMultiplexUpdate,
+		Delete: resourceAwsMediaLiveMultiplexDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.MultiplexCreateTimeout),
+			Delete: schema.DefaultTimeout(waiter.MultiplexDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"availability_zones": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 2,
+				MaxItems: 2,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"multiplex_settings": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"transport_stream_bitrate": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						"transport_stream_id": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						"transport_stream_reserved_bitrate": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+
+						"maximum_video_buffer_delay_milliseconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			// desired_state drives StartMultiplex/StopMultiplex, mirroring
+			// desired_state on resourceAwsMediaLiveChannel.
+			"desired_state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					medialive.MultiplexStateRunning,
+					medialive.MultiplexStateIdle,
+				}, false),
+			},
+
+			"pipelines_running_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"program_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsMediaLiveMultiplexCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	input := &medialive.CreateMultiplexInput{
+		Name:              aws.String(d.Get("name").(string)),
+		AvailabilityZones: expandStringList(d.Get("availability_zones").([]interface{})),
+		MultiplexSettings: expandMultiplexSettings(d.Get("multiplex_settings").([]interface{})),
+		RequestId:         aws.String(uuid.Must(uuid.NewRandom()).String()),
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().MedialiveTags()
+	}
+
+	resp, err := conn.CreateMultiplex(input)
+	if err != nil {
+		return fmt.Errorf("Error creating MediaLive Multiplex: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.Multiplex.Id))
+
+	if _, err := waiter.MultiplexCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("Error waiting MediaLive Multiplex (%s) to be created: %s", d.Id(), err)
+	}
+
+	if mediaLiveMultiplexDesiredStateRunning(d) {
+		if err := startMediaLiveMultiplex(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsMediaLiveMultiplexRead(d, meta)
+}
+
+func resourceAwsMediaLiveMultiplexRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	resp, err := conn.DescribeMultiplex(&medialive.DescribeMultiplexInput{
+		MultiplexId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] MediaLive Multiplex %s not found, error code (404)", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error describing MediaLive Multiplex(%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", aws.StringValue(resp.Arn))
+	d.Set("name", aws.StringValue(resp.Name))
+	d.Set("availability_zones", aws.StringValueSlice(resp.AvailabilityZones))
+	d.Set("pipelines_running_count", aws.Int64Value(resp.PipelinesRunningCount))
+	d.Set("program_count", aws.Int64Value(resp.ProgramCount))
+
+	if err := d.Set("multiplex_settings", flattenMultiplexSettings(resp.MultiplexSettings)); err != nil {
+		return fmt.Errorf("error setting multiplex_settings: %s", err)
+	}
+
+	if err := d.Set("tags", keyvaluetags.MedialiveKeyValueTags(resp.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaLiveMultiplexUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	if d.HasChange("name") || d.HasChange("multiplex_settings") {
+		input := &medialive.UpdateMultiplexInput{
+			MultiplexId: aws.String(d.Id()),
+			Name:        aws.String(d.Get("name").(string)),
+		}
+
+		if v, ok := d.GetOk("multiplex_settings"); ok {
+			input.MultiplexSettings = expandMultiplexSettings(v.([]interface{}))
+		}
+
+		if _, err := conn.UpdateMultiplex(input); err != nil {
+			return fmt.Errorf("Error updating MediaLive Multiplex(%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+		if err := keyvaluetags.MedialiveUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating tags: %s", err)
+		}
+	}
+
+	if d.HasChange("desired_state") {
+		if mediaLiveMultiplexDesiredStateRunning(d) {
+			if err := startMediaLiveMultiplex(conn, d.Id()); err != nil {
+				return err
+			}
+		} else {
+			if err := stopMediaLiveMultiplex(conn, d.Id()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceAwsMediaLiveMultiplexRead(d, meta)
+}
+
+func resourceAwsMediaLiveMultiplexDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	if mediaLiveMultiplexDesiredStateRunning(d) {
+		if err := stopMediaLiveMultiplex(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	_, err := conn.DeleteMultiplex(&medialive.DeleteMultiplexInput{
+		MultiplexId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting MediaLive Multiplex(%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Waiting for Media Live Multiplex (%s) deletion", d.Id())
+	if err := waiter.MultiplexDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("Error waiting for deleting MediaLive Multiplex(%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func mediaLiveMultiplexDesiredStateRunning(d *schema.ResourceData) bool {
+	return d.Get("desired_state").(string) == medialive.MultiplexStateRunning
+}
+
+func startMediaLiveMultiplex(conn *medialive.MediaLive, multiplexId string) error {
+	if _, err := conn.StartMultiplex(&medialive.StartMultiplexInput{MultiplexId: aws.String(multiplexId)}); err != nil {
+		return fmt.Errorf("Error starting MediaLive Multiplex(%s): %s", multiplexId, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for Media Live Multiplex (%s) to start", multiplexId)
+	if _, err := waiter.MultiplexRunning(conn, multiplexId, waiter.MultiplexStartTimeout); err != nil {
+		return fmt.Errorf("Error waiting for MediaLive Multiplex (%s) to start: %s", multiplexId, err)
+	}
+
+	return nil
+}
+
+func stopMediaLiveMultiplex(conn *medialive.MediaLive, multiplexId string) error {
+	resp, err := conn.DescribeMultiplex(&medialive.DescribeMultiplexInput{MultiplexId: aws.String(multiplexId)})
+	if err != nil {
+		return fmt.Errorf("Error describing MediaLive Multiplex(%s): %s", multiplexId, err)
+	}
+
+	if aws.StringValue(resp.State) == medialive.MultiplexStateIdle {
+		return nil
+	}
+
+	if _, err := conn.StopMultiplex(&medialive.StopMultiplexInput{MultiplexId: aws.String(multiplexId)}); err != nil {
+		return fmt.Errorf("Error stopping MediaLive Multiplex(%s): %s", multiplexId, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for Media Live Multiplex (%s) to stop", multiplexId)
+	if _, err := waiter.MultiplexStopped(conn, multiplexId, waiter.MultiplexStopTimeout); err != nil {
+		return fmt.Errorf("Error waiting for MediaLive Multiplex (%s) to stop: %s", multiplexId, err)
+	}
+
+	return nil
+}
+
+func expandMultiplexSettings(s []interface{}) *medialive.MultiplexSettings {
+	if len(s) == 0 {
+		return nil
+	}
+	settings := s[0].(map[string]interface{})
+
+	result := &medialive.MultiplexSettings{
+		TransportStreamBitrate: aws.Int64(int64(settings["transport_stream_bitrate"].(int))),
+		TransportStreamId:      aws.Int64(int64(settings["transport_stream_id"].(int))),
+	}
+
+	if v := settings["transport_stream_reserved_bitrate"].(int); v > 0 {
+		result.TransportStreamReservedBitrate = aws.Int64(int64(v))
+	}
+
+	if v := settings["maximum_video_buffer_delay_milliseconds"].(int); v > 0 {
+		result.MaximumVideoBufferDelayMilliseconds = aws.Int64(int64(v))
+	}
+
+	return result
+}
+
+func flattenMultiplexSettings(settings *medialive.MultiplexSettings) []interface{} {
+	if settings == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"transport_stream_bitrate":                aws.Int64Value(settings.TransportStreamBitrate),
+			"transport_stream_id":                     aws.Int64Value(settings.TransportStreamId),
+			"transport_stream_reserved_bitrate":        aws.Int64Value(settings.TransportStreamReservedBitrate),
+			"maximum_video_buffer_delay_milliseconds": aws.Int64Value(settings.MaximumVideoBufferDelayMilliseconds),
+		},
+	}
+}
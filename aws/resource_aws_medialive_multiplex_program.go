@@ -0,0 +1,341 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceAwsMediaLiveMultiplexProgram manages a single program within a
+// multiplex's transport stream. Its ID is "multiplex_id/program_name", the
+// same composite-ID convention used by
+// resourceAwsMediaLiveChannelScheduleAction for actions scoped to a parent
+// channel, since DescribeMultiplexProgram/UpdateMultiplexProgram/
+// DeleteMultiplexProgram all key off that pair rather than a standalone ID.
+func resourceAwsMediaLiveMultiplexProgram() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaLiveMultiplexProgramCreate,
+		Read:   resourceAwsMediaLiveMultiplexProgramRead,
+		Update: resourceAwsMediaLiveMultiplexProgramUpdate,
+		Delete: resourceAwsMediaLiveMultiplexProgramDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"multiplex_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"program_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"multiplex_program_settings": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"program_number": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						"preferred_channel_pipeline": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"service_descriptor": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"provider_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"service_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+
+						// Exactly one of constant_bitrate or statmux_settings must be
+						// configured; see expandMultiplexProgramVideoSettings.
+						"video_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"constant_bitrate": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+
+									"statmux_settings": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"minimum_bitrate": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+
+												"maximum_bitrate": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+
+												"priority": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsMediaLiveMultiplexProgramCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	multiplexId := d.Get("multiplex_id").(string)
+	programName := d.Get("program_name").(string)
+
+	settings, err := expandMultiplexProgramSettings(d.Get("multiplex_program_settings").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.CreateMultiplexProgram(&medialive.CreateMultiplexProgramInput{
+		MultiplexId:              aws.String(multiplexId),
+		ProgramName:              aws.String(programName),
+		MultiplexProgramSettings: settings,
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating MediaLive Multiplex Program: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", multiplexId, programName))
+
+	return resourceAwsMediaLiveMultiplexProgramRead(d, meta)
+}
+
+func resourceAwsMediaLiveMultiplexProgramRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	multiplexId, programName, err := parseMediaLiveMultiplexProgramId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.DescribeMultiplexProgram(&medialive.DescribeMultiplexProgramInput{
+		MultiplexId: aws.String(multiplexId),
+		ProgramName: aws.String(programName),
+	})
+	if err != nil {
+		if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] MediaLive Multiplex Program %s not found, error code (404)", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error describing MediaLive Multiplex Program(%s): %s", d.Id(), err)
+	}
+
+	d.Set("multiplex_id", multiplexId)
+	d.Set("program_name", aws.StringValue(resp.ProgramName))
+
+	if err := d.Set("multiplex_program_settings", flattenMultiplexProgramSettings(resp.MultiplexProgramSettings)); err != nil {
+		return fmt.Errorf("error setting multiplex_program_settings: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaLiveMultiplexProgramUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	multiplexId, programName, err := parseMediaLiveMultiplexProgramId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	settings, err := expandMultiplexProgramSettings(d.Get("multiplex_program_settings").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.UpdateMultiplexProgram(&medialive.UpdateMultiplexProgramInput{
+		MultiplexId:              aws.String(multiplexId),
+		ProgramName:              aws.String(programName),
+		MultiplexProgramSettings: settings,
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating MediaLive Multiplex Program(%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsMediaLiveMultiplexProgramRead(d, meta)
+}
+
+func resourceAwsMediaLiveMultiplexProgramDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	multiplexId, programName, err := parseMediaLiveMultiplexProgramId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.DeleteMultiplexProgram(&medialive.DeleteMultiplexProgramInput{
+		MultiplexId: aws.String(multiplexId),
+		ProgramName: aws.String(programName),
+	})
+	if err != nil {
+		if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting MediaLive Multiplex Program(%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func parseMediaLiveMultiplexProgramId(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%s), expected multiplex_id/program_name", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func expandMultiplexProgramSettings(s []interface{}) (*medialive.MultiplexProgramSettings, error) {
+	if len(s) == 0 {
+		return nil, fmt.Errorf("multiplex_program_settings must be configured")
+	}
+	settings := s[0].(map[string]interface{})
+
+	result := &medialive.MultiplexProgramSettings{
+		ProgramNumber: aws.Int64(int64(settings["program_number"].(int))),
+	}
+
+	if v := settings["preferred_channel_pipeline"].(string); v != "" {
+		result.PreferredChannelPipeline = aws.String(v)
+	}
+
+	if v := settings["service_descriptor"].([]interface{}); len(v) > 0 {
+		raw := v[0].(map[string]interface{})
+		result.ServiceDescriptor = &medialive.MultiplexProgramServiceDescriptor{
+			ProviderName: aws.String(raw["provider_name"].(string)),
+			ServiceName:  aws.String(raw["service_name"].(string)),
+		}
+	}
+
+	if v := settings["video_settings"].([]interface{}); len(v) > 0 {
+		videoSettings, err := expandMultiplexProgramVideoSettings(v)
+		if err != nil {
+			return nil, err
+		}
+		result.VideoSettings = videoSettings
+	}
+
+	return result, nil
+}
+
+// expandMultiplexProgramVideoSettings dispatches to exactly one of
+// constant_bitrate (a fixed allocation) or statmux_settings (AWS Elemental's
+// statistical multiplexing, which lets MediaLive shift bitrate headroom
+// between programs as scene complexity changes).
+func expandMultiplexProgramVideoSettings(s []interface{}) (*medialive.MultiplexVideoSettings, error) {
+	settings := s[0].(map[string]interface{})
+	var configured []string
+
+	result := &medialive.MultiplexVideoSettings{}
+
+	if v := settings["constant_bitrate"].(int); v > 0 {
+		configured = append(configured, "constant_bitrate")
+		result.ConstantBitrate = aws.Int64(int64(v))
+	}
+
+	if v := settings["statmux_settings"].([]interface{}); len(v) > 0 {
+		configured = append(configured, "statmux_settings")
+		raw := v[0].(map[string]interface{})
+		statmux := &medialive.MultiplexStatmuxVideoSettings{}
+		if v := raw["minimum_bitrate"].(int); v > 0 {
+			statmux.MinimumBitrate = aws.Int64(int64(v))
+		}
+		if v := raw["maximum_bitrate"].(int); v > 0 {
+			statmux.MaximumBitrate = aws.Int64(int64(v))
+		}
+		if v := raw["priority"].(int); v != 0 {
+			statmux.Priority = aws.Int64(int64(v))
+		}
+		result.StatmuxSettings = statmux
+	}
+
+	if len(configured) != 1 {
+		return nil, fmt.Errorf("exactly one of constant_bitrate or statmux_settings must be configured, got %d: %s", len(configured), configured)
+	}
+
+	return result, nil
+}
+
+func flattenMultiplexProgramSettings(settings *medialive.MultiplexProgramSettings) []interface{} {
+	if settings == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"program_number":             aws.Int64Value(settings.ProgramNumber),
+		"preferred_channel_pipeline": aws.StringValue(settings.PreferredChannelPipeline),
+	}
+
+	if settings.ServiceDescriptor != nil {
+		m["service_descriptor"] = []interface{}{
+			map[string]interface{}{
+				"provider_name": aws.StringValue(settings.ServiceDescriptor.ProviderName),
+				"service_name":  aws.StringValue(settings.ServiceDescriptor.ServiceName),
+			},
+		}
+	}
+
+	if settings.VideoSettings != nil {
+		video := map[string]interface{}{
+			"constant_bitrate": aws.Int64Value(settings.VideoSettings.ConstantBitrate),
+		}
+		if settings.VideoSettings.StatmuxSettings != nil {
+			video["statmux_settings"] = []interface{}{
+				map[string]interface{}{
+					"minimum_bitrate": aws.Int64Value(settings.VideoSettings.StatmuxSettings.MinimumBitrate),
+					"maximum_bitrate": aws.Int64Value(settings.VideoSettings.StatmuxSettings.MaximumBitrate),
+					"priority":        aws.Int64Value(settings.VideoSettings.StatmuxSettings.Priority),
+				},
+			}
+		}
+		m["video_settings"] = []interface{}{video}
+	}
+
+	return []interface{}{m}
+}